@@ -0,0 +1,102 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/currency"
+)
+
+// CurrencyAlias maps a currency code that isn't a current ISO 4217 code to one that is,
+// e.g. the informal "RMB" to CNY, or the superseded TRL to TRY.
+type CurrencyAlias struct {
+	// Code is the current ISO 4217 code.
+	Code string
+	// Scale is the value in Code of one unit of the alias, e.g. 1/1000000 for TRL,
+	// which was replaced by TRY at a million to one. A nil Scale is 1.
+	Scale *big.Rat
+}
+
+var aliases = struct {
+	sync.RWMutex
+	m map[string]CurrencyAlias
+}{
+	m: map[string]CurrencyAlias{
+		// Informal names.
+		"CNH": {Code: "CNY"},
+		"NIS": {Code: "ILS"},
+		"NTD": {Code: "TWD"},
+		"RMB": {Code: "CNY"},
+		// Superseded codes, with the rate at which they were redenominated.
+		"AZM": {Code: "AZN", Scale: big.NewRat(1, 5000)},
+		"BYR": {Code: "BYN", Scale: big.NewRat(1, 10000)},
+		"CSD": {Code: "RSD"},
+		"GHC": {Code: "GHS", Scale: big.NewRat(1, 10000)},
+		"MZM": {Code: "MZN", Scale: big.NewRat(1, 1000)},
+		"ROL": {Code: "RON", Scale: big.NewRat(1, 10000)},
+		"RUR": {Code: "RUB", Scale: big.NewRat(1, 1000)},
+		"SDD": {Code: "SDG", Scale: big.NewRat(1, 100)},
+		"TMM": {Code: "TMT", Scale: big.NewRat(1, 5000)},
+		"TRL": {Code: "TRY", Scale: big.NewRat(1, 1000000)},
+		"ZWD": {Code: "ZWL", Scale: new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(25), nil))},
+		"ZWN": {Code: "ZWL", Scale: new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(22), nil))},
+		"ZWR": {Code: "ZWL", Scale: big.NewRat(1, 1000000000000)},
+	},
+}
+
+// RegisterAlias sets the mapping for the currency code alias, replacing any existing mapping.
+// Aliases are only used when enabled with ParseOptions.AllowAliases.
+// It returns an error if a.Code isn't a recognised currency, or a.Scale isn't positive.
+func RegisterAlias(alias string, a CurrencyAlias) error {
+	if _, err := currency.ParseISO(a.Code); err != nil {
+		return fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if a.Scale != nil && a.Scale.Sign() <= 0 {
+		return fmt.Errorf("alias scale must be positive, got %s", a.Scale.RatString())
+	}
+	aliases.Lock()
+	defer aliases.Unlock()
+	aliases.m[strings.ToUpper(alias)] = a
+	return nil
+}
+
+// LookupAlias returns the mapping for the currency code alias, which is case-insensitive.
+// Common informal and superseded codes are registered by default.
+func LookupAlias(alias string) (CurrencyAlias, bool) {
+	aliases.RLock()
+	defer aliases.RUnlock()
+	a, ok := aliases.m[strings.ToUpper(alias)]
+	return a, ok
+}
+
+// newFromAlias returns a new Money in the currency a maps to, for amt units of the alias.
+// It returns an error if the result can't be represented exactly.
+func newFromAlias(alias string, a CurrencyAlias, amt string, opts ParseOptions) (Money, error) {
+	if a.Scale == nil || a.Scale.Cmp(big.NewRat(1, 1)) == 0 {
+		opts.AllowAliases = false
+		return NewWithOptions(a.Code, amt, opts)
+	}
+	c, err := currency.ParseISO(a.Code)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	s, err := normalizeAmount(c, amt, opts)
+	if err != nil {
+		return Money{}, err
+	}
+	r, err := parseDecimal(s)
+	if err != nil {
+		return Money{}, badAmount(amt)
+	}
+	r.Mul(r, a.Scale)
+	x, err := fromRat(c, r, Down)
+	if err != nil {
+		return Money{}, err
+	}
+	if x.Rat().Cmp(r) != 0 {
+		return Money{}, fmt.Errorf("%s %s can't be represented exactly in %s", alias, amt, a.Code)
+	}
+	return x, nil
+}
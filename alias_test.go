@@ -0,0 +1,74 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanParseAliases(t *testing.T) {
+	opts := ParseOptions{AllowAliases: true}
+	var cases = []struct {
+		cur  string
+		amt  string
+		opts ParseOptions
+		want string
+	}{
+		{"RMB", "12.34", opts, "CNY 12.34"},
+		{"rmb", "12.34", opts, "CNY 12.34"},
+		{"NIS", "5.00", opts, "ILS 5.00"},
+		{"TRL", "1500000", opts, "TRY 1.50"},
+		{"TRL", "-2,000,000", ParseOptions{AllowAliases: true, AllowGrouping: true}, "TRY -2.00"},
+		{"RUR", "1230", opts, "RUB 1.23"},
+		{"GBP", "1.00", opts, "GBP 1.00"},
+		{"TRY", "1.00", opts, "TRY 1.00"},
+	}
+	for _, c := range cases {
+		got, err := NewWithOptions(c.cur, c.amt, c.opts)
+		if err != nil || got.String() != c.want {
+			t.Errorf("NewWithOptions(%s, %s): wanted %s, got %v (%v)", c.cur, c.amt, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadAliases(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		opts ParseOptions
+	}{
+		{"RMB", "12.34", ParseOptions{}},
+		{"RMB", "12.345", ParseOptions{AllowAliases: true}},
+		{"TRL", "1500001", ParseOptions{AllowAliases: true}},
+		{"RUR", "1234.50", ParseOptions{AllowAliases: true}},
+		{"TRL", "1,500,000", ParseOptions{AllowAliases: true}},
+		{"TRL", "abc", ParseOptions{AllowAliases: true}},
+	}
+	for _, c := range cases {
+		if got, err := NewWithOptions(c.cur, c.amt, c.opts); err == nil {
+			t.Errorf("error expected from NewWithOptions(%s, %s, %+v), none received, got %v", c.cur, c.amt, c.opts, got)
+		}
+	}
+}
+
+func TestCanRegisterAlias(t *testing.T) {
+	defer func() {
+		aliases.Lock()
+		delete(aliases.m, "GBX")
+		aliases.Unlock()
+	}()
+	if err := RegisterAlias("gbx", CurrencyAlias{Code: "GBP", Scale: big.NewRat(1, 100)}); err != nil {
+		t.Fatalf("error received from RegisterAlias, none expected %v", err)
+	}
+	if got, err := NewWithOptions("GBX", "1250", ParseOptions{AllowAliases: true}); err != nil || got.String() != "GBP 12.50" {
+		t.Errorf("wanted GBP 12.50 from GBX 1250, got %v (%v)", got, err)
+	}
+	if a, ok := LookupAlias("GBX"); !ok || a.Code != "GBP" {
+		t.Errorf("LookupAlias(GBX): wanted GBP, got %v (%v)", a, ok)
+	}
+	if err := RegisterAlias("FOO", CurrencyAlias{Code: "BAR"}); err == nil {
+		t.Errorf("error expected registering alias to unknown currency, none received")
+	}
+	if err := RegisterAlias("FOO", CurrencyAlias{Code: "GBP", Scale: new(big.Rat)}); err == nil {
+		t.Errorf("error expected registering alias with zero scale, none received")
+	}
+}
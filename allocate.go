@@ -0,0 +1,35 @@
+package dough
+
+import "math/big"
+
+// allocate splits total minor units in proportion to weights, which must be non-negative
+// and not all zero. Each portion is the exact share rounded towards zero, and spare units
+// are then distributed one at a time, from first to last, to portions with a non-zero weight.
+// The result is exact: it always sums to total.
+func allocate(total int, weights []int64) []int {
+	sum := new(big.Int)
+	for _, w := range weights {
+		sum.Add(sum, big.NewInt(w))
+	}
+	res := make([]int, len(weights))
+	rem := total
+	for i, w := range weights {
+		p := new(big.Int).Mul(big.NewInt(int64(total)), big.NewInt(w))
+		p.Quo(p, sum)
+		res[i] = int(p.Int64())
+		rem -= res[i]
+	}
+	d := 1
+	if rem < 0 {
+		d = -1
+	}
+	for i := 0; rem != 0; i++ {
+		ind := i % len(weights)
+		if weights[ind] == 0 {
+			continue
+		}
+		res[ind] += d
+		rem -= d
+	}
+	return res
+}
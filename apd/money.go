@@ -0,0 +1,53 @@
+// Package apd converts between Money and github.com/cockroachdb/apd/v3 decimals.
+//
+// Conversion to a decimal is always exact. Conversion from a decimal rounds to the currency's
+// minor unit under an explicit apd.Context, whose Rounding and Traps apply as they would to any
+// other apd operation.
+package apd
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/itsoneiota/dough-go"
+)
+
+// Money is a dough.Money that converts to an apd.Decimal.
+type Money struct {
+	dough.Money
+}
+
+// Decimal returns the exact amount of m in major units, with the currency's exponent,
+// e.g. 12345E-2 for £123.45.
+func (m Money) Decimal() *apd.Decimal {
+	return apd.New(m.MinorUnits(), -int32(m.Exponent()))
+}
+
+// FromDecimal returns the Money in the given currency nearest to d, quantized to the currency's
+// minor unit under ctx, e.g. with ctx.Rounding of apd.RoundHalfEven for banker's rounding.
+// It returns an error if cur is not well formed or not recognised, if d is infinite or NaN,
+// if quantizing raises a condition that ctx traps, or if the result can't be represented.
+func FromDecimal(cur string, d *apd.Decimal, ctx *apd.Context) (dough.Money, error) {
+	z, err := dough.FromMinorUnits(cur, 0)
+	if err != nil {
+		return dough.Money{}, err
+	}
+	if d.Form != apd.Finite {
+		return dough.Money{}, fmt.Errorf("can't convert %s to %s", d, cur)
+	}
+	var q apd.Decimal
+	if _, err := ctx.Quantize(&q, d, -int32(z.Exponent())); err != nil {
+		return dough.Money{}, fmt.Errorf("can't convert %s to %s: %v", d, cur, err)
+	}
+	if q.Form != apd.Finite {
+		return dough.Money{}, fmt.Errorf("can't convert %s to %s with precision %d", d, cur, ctx.Precision)
+	}
+	units := q.Coeff.MathBigInt()
+	if q.Negative {
+		units.Neg(units)
+	}
+	if !units.IsInt64() {
+		return dough.Money{}, fmt.Errorf("%w: %s %s", dough.ErrOutOfRange, d, cur)
+	}
+	return dough.FromScaled(cur, units.Int64(), z.Exponent())
+}
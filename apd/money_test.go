@@ -0,0 +1,89 @@
+package apd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/itsoneiota/dough-go"
+)
+
+func roundingContext(r apd.Rounder) *apd.Context {
+	ctx := apd.BaseContext.WithPrecision(34)
+	ctx.Rounding = r
+	return ctx
+}
+
+func TestCanConvertToDecimal(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "123.45", "123.45"},
+		{"GBP", "-0.01", "-0.01"},
+		{"GBP", "1.20", "1.20"},
+		{"JPY", "500", "500"},
+		{"KWD", "1.234", "1.234"},
+		{"USD", "92233720368547758.07", "92233720368547758.07"},
+	}
+	for _, c := range cases {
+		x, _ := dough.New(c.cur, c.amt)
+		got := Money{x}.Decimal()
+		if got.String() != c.want {
+			t.Errorf("%v.Decimal(): wanted %s, got %s", x, c.want, got)
+		}
+		back, err := FromDecimal(c.cur, got, roundingContext(apd.RoundHalfUp))
+		if err != nil || back != x {
+			t.Errorf("FromDecimal(%s, %s): wanted %v, got %v (%v)", c.cur, got, x, back, err)
+		}
+	}
+}
+
+func TestCanConvertFromDecimal(t *testing.T) {
+	var cases = []struct {
+		cur      string
+		d        string
+		rounding apd.Rounder
+		want     string
+	}{
+		{"GBP", "1.2", apd.RoundHalfUp, "1.20"},
+		{"GBP", "1.005", apd.RoundHalfUp, "1.01"},
+		{"GBP", "1.005", apd.RoundHalfEven, "1.00"},
+		{"GBP", "-1.005", apd.RoundHalfUp, "-1.01"},
+		{"GBP", "1.001", apd.RoundCeiling, "1.01"},
+		{"JPY", "99.9", apd.RoundDown, "99"},
+		{"GBP", "1E+3", apd.RoundHalfUp, "1000.00"},
+		{"GBP", "-0", apd.RoundHalfUp, "0.00"},
+	}
+	for _, c := range cases {
+		d, _, _ := apd.NewFromString(c.d)
+		got, err := FromDecimal(c.cur, d, roundingContext(c.rounding))
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("FromDecimal(%s, %s, %s): wanted %s, got %v (%v)", c.cur, c.d, c.rounding, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadDecimal(t *testing.T) {
+	var cases = []struct {
+		cur string
+		d   string
+		ctx *apd.Context
+	}{
+		{"FOO", "1", roundingContext(apd.RoundHalfUp)},
+		{"GBP", "NaN", roundingContext(apd.RoundHalfUp)},
+		{"GBP", "Infinity", roundingContext(apd.RoundHalfUp)},
+		{"GBP", "123456.78", apd.BaseContext.WithPrecision(4)},
+	}
+	for _, c := range cases {
+		d, _, _ := apd.NewFromString(c.d)
+		if got, err := FromDecimal(c.cur, d, c.ctx); err == nil {
+			t.Errorf("error expected from FromDecimal(%s, %s), none received, got %v", c.cur, c.d, got)
+		}
+	}
+	d, _, _ := apd.NewFromString("1E+30")
+	if _, err := FromDecimal("GBP", d, roundingContext(apd.RoundHalfUp)); !errors.Is(err, dough.ErrOutOfRange) {
+		t.Errorf("FromDecimal(GBP, 1E+30): wanted ErrOutOfRange, got %v", err)
+	}
+}
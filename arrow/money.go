@@ -0,0 +1,173 @@
+// Package arrow writes Money columns to Apache Arrow arrays and Parquet files.
+//
+// A column of Money called name is stored as two Arrow fields: name_currency,
+// a dictionary-encoded string of currency codes, and name_amount, a decimal128
+// of the amounts at a fixed scale. The scale must be at least the exponent of
+// every currency in the column.
+package arrow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/itsoneiota/dough-go"
+)
+
+// Precision is the precision of the decimal128 amount field.
+const Precision = 38
+
+// Fields returns the Arrow fields for a Money column called name, with amounts at the given scale.
+func Fields(name string, scale int32) []arrow.Field {
+	return []arrow.Field{
+		{
+			Name: name + "_currency",
+			Type: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.BinaryTypes.String},
+		},
+		{
+			Name: name + "_amount",
+			Type: &arrow.Decimal128Type{Precision: Precision, Scale: scale},
+		},
+	}
+}
+
+// Columns returns the currency and amount arrays for ms, with amounts at the given scale.
+// The caller must release the arrays.
+// It returns an error if scale is greater than Precision, or if any amount can't be represented
+// exactly at that scale within Precision digits.
+func Columns(mem memory.Allocator, ms []dough.Money, scale int32) ([]arrow.Array, error) {
+	if scale > Precision {
+		return nil, fmt.Errorf("scale %d is greater than the precision %d", scale, Precision)
+	}
+	fs := Fields("", scale)
+	cb := array.NewDictionaryBuilder(mem, fs[0].Type.(*arrow.DictionaryType)).(*array.BinaryDictionaryBuilder)
+	defer cb.Release()
+	ab := array.NewDecimal128Builder(mem, fs[1].Type.(*arrow.Decimal128Type))
+	defer ab.Release()
+	for i, m := range ms {
+		if int(scale) < m.Exponent() {
+			return nil, fmt.Errorf("can't write %v at row %d at scale %d: currency exponent is %d", m, i, scale, m.Exponent())
+		}
+		if err := cb.AppendString(m.Currency()); err != nil {
+			return nil, err
+		}
+		n := big.NewInt(m.MinorUnits())
+		n.Mul(n, pow10(int(scale)-m.Exponent()))
+		if len(new(big.Int).Abs(n).Text(10)) > Precision {
+			return nil, fmt.Errorf("can't write %v at row %d at scale %d: more than %d digits", m, i, scale, Precision)
+		}
+		ab.Append(decimal128.FromBigInt(n))
+	}
+	return []arrow.Array{cb.NewArray(), ab.NewArray()}, nil
+}
+
+// NewRecord returns an Arrow record with a single Money column called name, with amounts at the given scale.
+// The caller must release the record.
+// It returns an error as for Columns.
+func NewRecord(mem memory.Allocator, name string, ms []dough.Money, scale int32) (arrow.RecordBatch, error) {
+	cols, err := Columns(mem, ms, scale)
+	if err != nil {
+		return nil, err
+	}
+	defer cols[0].Release()
+	defer cols[1].Release()
+	return array.NewRecordBatch(arrow.NewSchema(Fields(name, scale), nil), cols, int64(len(ms))), nil
+}
+
+// FromColumns returns the Money values held in currency and amount arrays as built by Columns.
+// Amounts at a scale less than their currency's exponent, e.g. whole pounds at scale 0, are read too.
+// It returns an error if the arrays are of the wrong type or length, contain nulls,
+// or hold amounts that can't be represented exactly in their currency.
+func FromColumns(cur, amt arrow.Array) ([]dough.Money, error) {
+	cd, ok := cur.(*array.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("currency column must be a dictionary, got %s", cur.DataType())
+	}
+	cv, ok := cd.Dictionary().(*array.String)
+	if !ok {
+		return nil, fmt.Errorf("currency column must be a dictionary of strings, got %s", cur.DataType())
+	}
+	ad, ok := amt.(*array.Decimal128)
+	if !ok {
+		return nil, fmt.Errorf("amount column must be decimal128, got %s", amt.DataType())
+	}
+	if cd.Len() != ad.Len() {
+		return nil, fmt.Errorf("column lengths differ: %d currencies, %d amounts", cd.Len(), ad.Len())
+	}
+	scale := int(ad.DataType().(*arrow.Decimal128Type).Scale)
+	ms := make([]dough.Money, cd.Len())
+	for i := range ms {
+		if cd.IsNull(i) || ad.IsNull(i) {
+			return nil, fmt.Errorf("null value at row %d", i)
+		}
+		c := cv.Value(cd.GetValueIndex(i))
+		z, err := dough.FromMinorUnits(c, 0)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i, err)
+		}
+		var r big.Int
+		n := ad.Value(i).BigInt()
+		if scale >= z.Exponent() {
+			n.QuoRem(n, pow10(scale-z.Exponent()), &r)
+		} else {
+			n.Mul(n, pow10(z.Exponent()-scale))
+		}
+		if r.Sign() != 0 || !n.IsInt64() {
+			return nil, fmt.Errorf("row %d: amount %s can't be represented in %s", i, ad.ValueStr(i), c)
+		}
+		ms[i], _ = dough.FromMinorUnits(c, n.Int64())
+	}
+	return ms, nil
+}
+
+// WriteParquet writes ms to w as a Parquet file with a single Money column called name,
+// with amounts at the given scale.
+// It returns an error as for Columns, or if writing fails.
+func WriteParquet(w io.Writer, name string, ms []dough.Money, scale int32) error {
+	rec, err := NewRecord(memory.DefaultAllocator, name, ms, scale)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+	tbl := array.NewTableFromRecords(rec.Schema(), []arrow.RecordBatch{rec})
+	defer tbl.Release()
+	props := pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema())
+	return pqarrow.WriteTable(tbl, w, int64(len(ms))+1, parquet.NewWriterProperties(), props)
+}
+
+// ReadParquet reads the Money column called name from a Parquet file written by WriteParquet.
+func ReadParquet(r parquet.ReaderAtSeeker, name string) ([]dough.Money, error) {
+	tbl, err := pqarrow.ReadTable(context.Background(), r, parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Release()
+	var ms []dough.Money
+	cur, amt := tbl.Schema().FieldIndices(name+"_currency"), tbl.Schema().FieldIndices(name+"_amount")
+	if len(cur) != 1 || len(amt) != 1 {
+		return nil, fmt.Errorf("no Money column called %q", name)
+	}
+	cc, ac := tbl.Column(cur[0]).Data().Chunks(), tbl.Column(amt[0]).Data().Chunks()
+	if len(cc) != len(ac) {
+		return nil, fmt.Errorf("column %q is chunked inconsistently", name)
+	}
+	for i := range cc {
+		chunk, err := FromColumns(cc[i], ac[i])
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, chunk...)
+	}
+	return ms, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
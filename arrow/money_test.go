@@ -0,0 +1,142 @@
+package arrow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/itsoneiota/dough-go"
+)
+
+func testMoney(t *testing.T) []dough.Money {
+	var ms []dough.Money
+	for _, s := range []string{"GBP 123.45", "EUR -0.01", "GBP 0.00", "USD 92233720368547758.07", "EUR 1.00"} {
+		m, err := dough.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ms = append(ms, m)
+	}
+	return ms
+}
+
+func TestCanBuildColumns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+	ms := testMoney(t)
+	cols, err := Columns(mem, ms, 4)
+	if err != nil {
+		t.Fatalf("error received from Columns, none expected %v", err)
+	}
+	defer cols[0].Release()
+	defer cols[1].Release()
+	if n := cols[0].(*array.Dictionary).Dictionary().Len(); n != 3 {
+		t.Errorf("wanted 3 distinct currencies in dictionary, got %d", n)
+	}
+	if got := cols[1].(*array.Decimal128).Value(0).BigInt().Int64(); got != 1234500 {
+		t.Errorf("wanted unscaled amount 1234500, got %d", got)
+	}
+	got, err := FromColumns(cols[0], cols[1])
+	if err != nil {
+		t.Fatalf("error received from FromColumns, none expected %v", err)
+	}
+	for i := range ms {
+		if got[i] != ms[i] {
+			t.Errorf("row %d: wanted %v, got %v", i, ms[i], got[i])
+		}
+	}
+}
+
+func TestCanRejectScaleBelowExponent(t *testing.T) {
+	if _, err := Columns(memory.DefaultAllocator, testMoney(t), 1); err == nil {
+		t.Errorf("error expected building columns at scale 1, none received")
+	}
+}
+
+func TestCanRejectAmountBeyondPrecision(t *testing.T) {
+	var cases = []struct {
+		amt   string
+		scale int32
+		ok    bool
+	}{
+		{"GBP 99999999.99", 30, true},
+		{"GBP -99999999.99", 30, true},
+		{"GBP 0.01", 38, true},
+		{"GBP 100000000.00", 30, false},
+		{"GBP 12345678901.00", 30, false},
+		{"GBP 0.01", 39, false},
+		{"GBP 0.00", 39, false},
+	}
+	for _, c := range cases {
+		m, err := dough.Parse(c.amt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+		cols, err := Columns(mem, []dough.Money{m}, c.scale)
+		if (err == nil) != c.ok {
+			t.Errorf("Columns(%s, %d): wanted ok %t, got %v", c.amt, c.scale, c.ok, err)
+		}
+		if err == nil {
+			cols[0].Release()
+			cols[1].Release()
+		}
+		mem.AssertSize(t, 0)
+		var b bytes.Buffer
+		if err := WriteParquet(&b, "price", []dough.Money{m}, c.scale); (err == nil) != c.ok {
+			t.Errorf("WriteParquet(%s, %d): wanted ok %t, got %v", c.amt, c.scale, c.ok, err)
+		}
+	}
+}
+
+func TestCanReadScaleBelowExponent(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+	fs := Fields("", 0)
+	cb := array.NewDictionaryBuilder(mem, fs[0].Type.(*arrow.DictionaryType)).(*array.BinaryDictionaryBuilder)
+	defer cb.Release()
+	ab := array.NewDecimal128Builder(mem, fs[1].Type.(*arrow.Decimal128Type))
+	defer ab.Release()
+	for _, c := range []string{"GBP", "JPY", "KWD"} {
+		cb.AppendString(c)
+		ab.Append(decimal128.FromI64(5))
+	}
+	cur, amt := cb.NewArray(), ab.NewArray()
+	defer cur.Release()
+	defer amt.Release()
+	got, err := FromColumns(cur, amt)
+	if err != nil {
+		t.Fatalf("error received from FromColumns, none expected %v", err)
+	}
+	for i, want := range []string{"GBP 5.00", "JPY 5", "KWD 5.000"} {
+		if got[i].String() != want {
+			t.Errorf("row %d: wanted %s, got %v", i, want, got[i])
+		}
+	}
+}
+
+func TestCanRoundTripParquet(t *testing.T) {
+	ms := testMoney(t)
+	var b bytes.Buffer
+	if err := WriteParquet(&b, "price", ms, 2); err != nil {
+		t.Fatalf("error received from WriteParquet, none expected %v", err)
+	}
+	got, err := ReadParquet(bytes.NewReader(b.Bytes()), "price")
+	if err != nil {
+		t.Fatalf("error received from ReadParquet, none expected %v", err)
+	}
+	if len(got) != len(ms) {
+		t.Fatalf("wanted %d rows, got %d", len(ms), len(got))
+	}
+	for i := range ms {
+		if got[i] != ms[i] {
+			t.Errorf("row %d: wanted %v, got %v", i, ms[i], got[i])
+		}
+	}
+	if _, err := ReadParquet(bytes.NewReader(b.Bytes()), "cost"); err == nil {
+		t.Errorf("error expected reading missing column, none received")
+	}
+}
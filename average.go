@@ -0,0 +1,38 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// WeightedAverage returns the average of prices weighted by weights, e.g. the average cost of
+// inventory lots weighted by quantity. The weighted sum is accumulated exactly, and rounded
+// once using mode.
+// It returns an error if there are no prices, prices and weights have different lengths,
+// prices are in different currencies, or the weights sum to zero.
+func WeightedAverage(prices []Money, weights []uint, mode RoundingMode) (Money, error) {
+	if len(prices) == 0 {
+		return Money{}, fmt.Errorf("can't average no prices")
+	}
+	if len(prices) != len(weights) {
+		return Money{}, fmt.Errorf("got %d prices but %d weights", len(prices), len(weights))
+	}
+	sum := new(big.Int)
+	total := new(big.Int)
+	for i, p := range prices {
+		if p.Currency() != prices[0].Currency() {
+			return Money{}, fmt.Errorf("Can't average different currencies (%s and %s)", prices[0].Currency(), p.Currency())
+		}
+		w := new(big.Int).SetUint64(uint64(weights[i]))
+		total.Add(total, w)
+		sum.Add(sum, w.Mul(w, big.NewInt(int64(p.a))))
+	}
+	if total.Sign() == 0 {
+		return Money{}, fmt.Errorf("weights must not sum to zero")
+	}
+	a, err := roundAtoms(new(big.Rat).SetFrac(sum, total), mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{prices[0].c, a}, nil
+}
@@ -0,0 +1,52 @@
+package dough
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCanCalculateWeightedAverage(t *testing.T) {
+	var cases = []struct {
+		prices  []string
+		weights []uint
+		mode    RoundingMode
+		want    string
+	}{
+		{[]string{"10.00"}, []uint{3}, HalfUp, "10.00"},
+		{[]string{"10.00", "20.00"}, []uint{1, 1}, HalfUp, "15.00"},
+		{[]string{"10.00", "20.00"}, []uint{3, 1}, HalfUp, "12.50"},
+		{[]string{"1.00", "2.00"}, []uint{2, 1}, HalfUp, "1.33"},
+		{[]string{"1.00", "2.00"}, []uint{1, 2}, Down, "1.66"},
+		{[]string{"0.01", "0.02"}, []uint{1, 1}, HalfEven, "0.02"},
+		{[]string{"0.01", "0.02"}, []uint{1, 1}, HalfDown, "0.01"},
+		{[]string{"-1.00", "3.00"}, []uint{1, 0}, HalfUp, "-1.00"},
+		{[]string{"90000000000.00", "90000000000.00"}, []uint{math.MaxUint32, math.MaxUint32}, HalfUp, "90000000000.00"},
+	}
+	for _, c := range cases {
+		got, err := WeightedAverage(gbps(c.prices...), c.weights, c.mode)
+		if err != nil {
+			t.Errorf("error received from WeightedAverage(%v, %v), none expected %v", c.prices, c.weights, err)
+			continue
+		}
+		if got.Amount() != c.want {
+			t.Errorf("WeightedAverage(%v, %v, %v): wanted %s, got %s", c.prices, c.weights, c.mode, c.want, got.Amount())
+		}
+	}
+}
+
+func TestCanRejectBadWeightedAverage(t *testing.T) {
+	var cases = []struct {
+		prices  []Money
+		weights []uint
+	}{
+		{nil, nil},
+		{gbps("1.00"), []uint{1, 2}},
+		{[]Money{gbp("1.00"), MustNew("EUR", "1.00")}, []uint{1, 1}},
+		{gbps("1.00", "2.00"), []uint{0, 0}},
+	}
+	for _, c := range cases {
+		if got, err := WeightedAverage(c.prices, c.weights, HalfUp); err == nil {
+			t.Errorf("error expected from WeightedAverage(%v, %v), none received, got %v", c.prices, c.weights, got)
+		}
+	}
+}
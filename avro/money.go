@@ -0,0 +1,126 @@
+// Package avro maps Money to Avro records, using the decimal logical type for the amount.
+//
+// The record schema, as returned by Schema, has a string currency field and an amount field
+// of type bytes with logical type decimal. As Avro fixes the decimal scale in the schema,
+// all amounts in a field share the same scale, which must be at least the exponent of
+// any currency written to it.
+package avro
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Precision is the decimal precision declared in schemas returned by Schema.
+const Precision = 38
+
+// Record is the Avro representation of a Money.
+// Its struct tags are compatible with github.com/hamba/avro.
+type Record struct {
+	Currency string `avro:"currency"`
+	// Amount is the unscaled amount as a big-endian two's-complement integer.
+	Amount []byte `avro:"amount"`
+}
+
+// Schema returns the Avro schema for a Money record in the given namespace, with the given scale.
+func Schema(namespace string, scale int) string {
+	return fmt.Sprintf(`{"type":"record","name":"Money","namespace":%q,"fields":[`+
+		`{"name":"currency","type":"string"},`+
+		`{"name":"amount","type":{"type":"bytes","logicalType":"decimal","precision":%d,"scale":%d}}]}`,
+		namespace, Precision, scale)
+}
+
+// Encode returns the Avro record for m, with its amount at the given scale.
+// It returns an error as for EncodeDecimal.
+func Encode(m dough.Money, scale int) (Record, error) {
+	b, err := EncodeDecimal(m, scale)
+	if err != nil {
+		return Record{}, err
+	}
+	return Record{
+		Currency: m.Currency(),
+		Amount:   b,
+	}, nil
+}
+
+// Decode returns the Money for an Avro record with its amount at the given scale.
+// It returns an error if the currency isn't recognised, or the amount can't be
+// represented exactly in the currency's minor units.
+func Decode(r Record, scale int) (dough.Money, error) {
+	return DecodeDecimal(r.Currency, r.Amount, scale)
+}
+
+// EncodeDecimal returns the amount of m as Avro decimal bytes at the given scale.
+// It returns an error if m can't be represented exactly at that scale within Precision digits,
+// as readers following the schema would reject or misread it.
+func EncodeDecimal(m dough.Money, scale int) ([]byte, error) {
+	if scale < m.Exponent() {
+		return nil, fmt.Errorf("can't encode %v at scale %d: currency exponent is %d", m, scale, m.Exponent())
+	}
+	n := big.NewInt(m.MinorUnits())
+	n.Mul(n, pow10(scale-m.Exponent()))
+	if len(new(big.Int).Abs(n).Text(10)) > Precision {
+		return nil, fmt.Errorf("can't encode %v at scale %d: more than %d digits", m, scale, Precision)
+	}
+	return twosComplement(n), nil
+}
+
+// DecodeDecimal returns the Money for Avro decimal bytes at the given scale, in the given currency.
+// It returns an error if the currency isn't recognised, or the amount can't be
+// represented exactly in the currency's minor units.
+func DecodeDecimal(cur string, b []byte, scale int) (dough.Money, error) {
+	z, err := dough.FromMinorUnits(cur, 0)
+	if err != nil {
+		return dough.Money{}, err
+	}
+	n := fromTwosComplement(b)
+	if scale >= z.Exponent() {
+		var r big.Int
+		n.QuoRem(n, pow10(scale-z.Exponent()), &r)
+		if r.Sign() != 0 {
+			return dough.Money{}, fmt.Errorf("amount %s at scale %d can't be represented exactly in %s", fromTwosComplement(b), scale, cur)
+		}
+	} else {
+		n.Mul(n, pow10(z.Exponent()-scale))
+	}
+	if !n.IsInt64() {
+		return dough.Money{}, fmt.Errorf("amount out of range: %s %s minor units", n, cur)
+	}
+	return dough.FromMinorUnits(cur, n.Int64())
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// twosComplement returns the minimal big-endian two's-complement encoding of n.
+func twosComplement(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		b := n.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -n-1 has the same bits as n, inverted.
+	m := new(big.Int).Neg(n)
+	b := m.Sub(m, big.NewInt(1)).Bytes()
+	for i := range b {
+		b[i] = ^b[i]
+	}
+	if len(b) == 0 || b[0]&0x80 == 0 {
+		b = append([]byte{0xff}, b...)
+	}
+	return b
+}
+
+// fromTwosComplement decodes a big-endian two's-complement integer.
+func fromTwosComplement(b []byte) *big.Int {
+	n := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return n
+}
@@ -0,0 +1,121 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanEncodeDecimal(t *testing.T) {
+	var cases = []struct {
+		amt   string
+		scale int
+		want  []byte
+	}{
+		{"0.00", 2, []byte{0x00}},
+		{"0.01", 2, []byte{0x01}},
+		{"-0.01", 2, []byte{0xff}},
+		{"1.27", 2, []byte{0x7f}},
+		{"1.28", 2, []byte{0x00, 0x80}},
+		{"-1.28", 2, []byte{0x80}},
+		{"-1.29", 2, []byte{0xff, 0x7f}},
+		{"123.45", 2, []byte{0x30, 0x39}},
+		{"123.45", 4, []byte{0x12, 0xd6, 0x44}},
+	}
+	for _, c := range cases {
+		m, _ := dough.New("GBP", c.amt)
+		got, err := EncodeDecimal(m, c.scale)
+		if err != nil {
+			t.Errorf("error received from EncodeDecimal(%s, %d), none expected %v", c.amt, c.scale, err)
+		}
+		if !bytes.Equal(got, c.want) {
+			t.Errorf("EncodeDecimal(%s, %d): wanted % x, got % x", c.amt, c.scale, c.want, got)
+		}
+		back, err := DecodeDecimal("GBP", got, c.scale)
+		if err != nil {
+			t.Errorf("error received from DecodeDecimal(% x, %d), none expected %v", got, c.scale, err)
+		}
+		if back != m {
+			t.Errorf("DecodeDecimal(% x, %d): wanted %v, got %v", got, c.scale, m, back)
+		}
+	}
+}
+
+func TestCanRoundTripLargeDecimals(t *testing.T) {
+	for _, s := range []string{"92233720368547758.07", "-92233720368547758.07"} {
+		m, _ := dough.New("USD", s)
+		r, _ := Encode(m, 10)
+		got, err := Decode(r, 10)
+		if err != nil || got != m {
+			t.Errorf("wanted %v, got %v (%v)", m, got, err)
+		}
+		want, _ := new(big.Rat).SetString(s)
+		if n := fromTwosComplement(r.Amount); new(big.Rat).SetFrac(n, pow10(10)).Cmp(want) != 0 {
+			t.Errorf("wanted %s, got %se-10", s, n)
+		}
+	}
+}
+
+func TestCanRejectBadDecimal(t *testing.T) {
+	m, _ := dough.New("GBP", "1.00")
+	if _, err := Encode(m, 1); err == nil {
+		t.Errorf("error expected encoding at scale below exponent, none received")
+	}
+	if _, err := DecodeDecimal("GBP", []byte{0x01}, 3); err == nil {
+		t.Errorf("error expected decoding 0.001 GBP, none received")
+	}
+	if _, err := DecodeDecimal("FOO", []byte{0x01}, 2); err == nil {
+		t.Errorf("error expected decoding unknown currency, none received")
+	}
+	if _, err := DecodeDecimal("GBP", bytes.Repeat([]byte{0x7f}, 10), 2); err == nil {
+		t.Errorf("error expected decoding out of range amount, none received")
+	}
+}
+
+func TestCanRejectDecimalBeyondPrecision(t *testing.T) {
+	var cases = []struct {
+		amt   string
+		scale int
+		ok    bool
+	}{
+		{"99999999.99", 30, true},
+		{"-99999999.99", 30, true},
+		{"0.01", 38, true},
+		{"100000000.00", 30, false},
+		{"-100000000.00", 30, false},
+		{"92233720368547758.07", 30, false},
+	}
+	for _, c := range cases {
+		m, _ := dough.New("GBP", c.amt)
+		if _, err := EncodeDecimal(m, c.scale); (err == nil) != c.ok {
+			t.Errorf("EncodeDecimal(%v, %d): wanted ok %t, got %v", m, c.scale, c.ok, err)
+		}
+		if _, err := Encode(m, c.scale); (err == nil) != c.ok {
+			t.Errorf("Encode(%v, %d): wanted ok %t, got %v", m, c.scale, c.ok, err)
+		}
+	}
+}
+
+func TestCanGetSchema(t *testing.T) {
+	var s struct {
+		Name      string
+		Namespace string
+		Fields    []struct {
+			Name string
+			Type interface{}
+		}
+	}
+	if err := json.Unmarshal([]byte(Schema("com.example", 4)), &s); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	if s.Name != "Money" || s.Namespace != "com.example" || len(s.Fields) != 2 {
+		t.Errorf("unexpected schema %+v", s)
+	}
+	amt, _ := s.Fields[1].Type.(map[string]interface{})
+	if amt["logicalType"] != "decimal" || amt["scale"] != 4.0 {
+		t.Errorf("unexpected amount type %v", amt)
+	}
+}
@@ -0,0 +1,66 @@
+package dough
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/text/currency"
+)
+
+// Bag holds amounts in any number of currencies, e.g. the balances of a multi-currency account.
+// The zero value is an empty Bag ready to use.
+type Bag struct {
+	m map[currency.Unit]int
+}
+
+// NewBag returns a new Bag holding the sum of the given amounts.
+// It returns an error wrapping ErrOutOfRange if the sum in any currency would overflow.
+func NewBag(ms ...Money) (*Bag, error) {
+	b := &Bag{}
+	for _, m := range ms {
+		if err := b.Add(m); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Add adds m to the balance in m's currency.
+// It returns an error wrapping ErrOutOfRange, and leaves the balance unchanged, if it would overflow.
+func (b *Bag) Add(m Money) error {
+	if b.m == nil {
+		b.m = map[currency.Unit]int{}
+	}
+	z, ok := addAtoms(b.m[m.c], m.a)
+	if !ok {
+		return fmt.Errorf("%w: %v + %v", ErrOutOfRange, Money{m.c, b.m[m.c]}, m)
+	}
+	b.m[m.c] = z
+	return nil
+}
+
+// Sub subtracts m from the balance in m's currency.
+// It returns an error wrapping ErrOutOfRange, and leaves the balance unchanged, if it would overflow.
+func (b *Bag) Sub(m Money) error {
+	return b.Add(Money{m.c, -m.a})
+}
+
+// Balance returns the balance in the given currency, which is zero if nothing has been added in it.
+// It returns an error if cur is not well formed or not recognised.
+func (b Bag) Balance(cur string) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	return Money{c, b.m[c]}, nil
+}
+
+// Balances returns the balance in each currency that has been added to the Bag, ordered by currency code.
+func (b Bag) Balances() []Money {
+	res := make([]Money, 0, len(b.m))
+	for c, a := range b.m {
+		res = append(res, Money{c, a})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Currency() < res[j].Currency() })
+	return res
+}
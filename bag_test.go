@@ -0,0 +1,58 @@
+package dough
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCanUseBag(t *testing.T) {
+	b, err := NewBag(MustNew("USD", "10.00"), MustNew("GBP", "5.00"), MustNew("USD", "2.50"))
+	if err != nil {
+		t.Fatalf("error received from NewBag, none expected %v", err)
+	}
+	if err := b.Sub(MustNew("JPY", "100")); err != nil {
+		t.Errorf("error received from Sub, none expected %v", err)
+	}
+	if err := b.Add(MustNew("GBP", "-5.00")); err != nil {
+		t.Errorf("error received from Add, none expected %v", err)
+	}
+	want := []Money{MustNew("GBP", "0.00"), MustNew("JPY", "-100"), MustNew("USD", "12.50")}
+	if got := b.Balances(); !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted balances %v, got %v", want, got)
+	}
+	if got, err := b.Balance("USD"); err != nil || got.String() != "USD 12.50" {
+		t.Errorf("Balance(USD): wanted USD 12.50, got %v (%v)", got, err)
+	}
+	if got, err := b.Balance("EUR"); err != nil || got.String() != "EUR 0.00" {
+		t.Errorf("Balance(EUR): wanted EUR 0.00, got %v (%v)", got, err)
+	}
+	if _, err := b.Balance("FOO"); err == nil {
+		t.Errorf("error expected from Balance(FOO), none received")
+	}
+	var empty Bag
+	if got := empty.Balances(); len(got) != 0 {
+		t.Errorf("wanted no balances in empty Bag, got %v", got)
+	}
+	empty.Add(MustNew("EUR", "1.00"))
+	if got, _ := empty.Balance("EUR"); got.Amount() != "1.00" {
+		t.Errorf("wanted EUR 1.00 in zero Bag after Add, got %v", got)
+	}
+}
+
+func TestCanRejectBagOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	if _, err := NewBag(hi, gbp("0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("NewBag(%v, 0.01): wanted ErrOutOfRange, got %v", hi, err)
+	}
+	b, _ := NewBag(hi)
+	if err := b.Add(gbp("0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Add(0.01) to %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if err := b.Sub(gbp("-0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Sub(-0.01) from %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if got, _ := b.Balance("GBP"); got != hi {
+		t.Errorf("wanted balance unchanged by failed Add, got %v", got)
+	}
+}
@@ -0,0 +1,85 @@
+// Package bankfile formats Money as fixed-width amount fields for bank payment files.
+//
+// Amounts are written as zero-padded minor units with an implied decimal point,
+// e.g. £123.45 in an 11-digit field is 00000012345. Fields are unsigned: the
+// direction of a payment is given by its transaction code, not its amount.
+package bankfile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Field widths for amounts in common bank file formats.
+const (
+	// BACSWidth is the width of the amount field in a BACS Standard 18 record, in pence.
+	BACSWidth = 11
+	// NACHAWidth is the width of the amount field in a NACHA entry detail record, in cents.
+	NACHAWidth = 10
+)
+
+// Format returns m as a zero-padded implied-decimal field of the given width.
+// It returns an error if m is negative or doesn't fit.
+func Format(m dough.Money, width int) (string, error) {
+	if m.MinorUnits() < 0 {
+		return "", fmt.Errorf("can't write negative amount %v to a fixed-width field", m)
+	}
+	s := strconv.FormatInt(m.MinorUnits(), 10)
+	if len(s) > width {
+		return "", fmt.Errorf("%v doesn't fit in a %d-digit field", m, width)
+	}
+	return strings.Repeat("0", width-len(s)) + s, nil
+}
+
+// Parse returns the Money in the given currency for a zero-padded implied-decimal field.
+// It returns an error if s is empty or contains anything other than digits.
+func Parse(cur, s string) (dough.Money, error) {
+	if s == "" {
+		return dough.Money{}, fmt.Errorf("empty amount field")
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return dough.Money{}, fmt.Errorf("invalid amount field %q", s)
+		}
+	}
+	u, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return dough.Money{}, fmt.Errorf("invalid amount field %q: %v", s, err)
+	}
+	return dough.FromMinorUnits(cur, u)
+}
+
+// BACS returns the amount field of a BACS Standard 18 record for m, which must be in GBP.
+func BACS(m dough.Money) (string, error) {
+	if m.Currency() != "GBP" {
+		return "", fmt.Errorf("BACS amounts must be in GBP, got %s", m.Currency())
+	}
+	return Format(m, BACSWidth)
+}
+
+// ParseBACS returns the GBP amount in the amount field of a BACS Standard 18 record.
+func ParseBACS(s string) (dough.Money, error) {
+	if len(s) != BACSWidth {
+		return dough.Money{}, fmt.Errorf("BACS amount field must be %d digits, got %q", BACSWidth, s)
+	}
+	return Parse("GBP", s)
+}
+
+// NACHA returns the amount field of a NACHA entry detail record for m, which must be in USD.
+func NACHA(m dough.Money) (string, error) {
+	if m.Currency() != "USD" {
+		return "", fmt.Errorf("NACHA amounts must be in USD, got %s", m.Currency())
+	}
+	return Format(m, NACHAWidth)
+}
+
+// ParseNACHA returns the USD amount in the amount field of a NACHA entry detail record.
+func ParseNACHA(s string) (dough.Money, error) {
+	if len(s) != NACHAWidth {
+		return dough.Money{}, fmt.Errorf("NACHA amount field must be %d digits, got %q", NACHAWidth, s)
+	}
+	return Parse("USD", s)
+}
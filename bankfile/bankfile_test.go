@@ -0,0 +1,64 @@
+package bankfile
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanFormatBACSAndNACHA(t *testing.T) {
+	var cases = []struct {
+		amt   string
+		bacs  string
+		nacha string
+	}{
+		{"0.00", "00000000000", "0000000000"},
+		{"0.01", "00000000001", "0000000001"},
+		{"123.45", "00000012345", "0000012345"},
+		{"99999999.99", "09999999999", "9999999999"},
+	}
+	for _, c := range cases {
+		gbp, _ := dough.GBP(c.amt)
+		got, err := BACS(gbp)
+		if err != nil || got != c.bacs {
+			t.Errorf("BACS(%s): wanted %s, got %s (%v)", c.amt, c.bacs, got, err)
+		}
+		if back, err := ParseBACS(got); err != nil || back != gbp {
+			t.Errorf("ParseBACS(%s): wanted %v, got %v (%v)", got, gbp, back, err)
+		}
+		usd, _ := dough.USD(c.amt)
+		got, err = NACHA(usd)
+		if err != nil || got != c.nacha {
+			t.Errorf("NACHA(%s): wanted %s, got %s (%v)", c.amt, c.nacha, got, err)
+		}
+		if back, err := ParseNACHA(got); err != nil || back != usd {
+			t.Errorf("ParseNACHA(%s): wanted %v, got %v (%v)", got, usd, back, err)
+		}
+	}
+}
+
+func TestCanRejectBadFixedWidth(t *testing.T) {
+	neg, _ := dough.GBP("-0.01")
+	if _, err := BACS(neg); err == nil {
+		t.Errorf("error expected formatting negative amount, none received")
+	}
+	big, _ := dough.USD("100000000.00")
+	if _, err := NACHA(big); err == nil {
+		t.Errorf("error expected formatting amount too wide for field, none received")
+	}
+	eur, _ := dough.EUR("1.00")
+	if _, err := BACS(eur); err == nil {
+		t.Errorf("error expected formatting EUR as BACS, none received")
+	}
+	if _, err := NACHA(eur); err == nil {
+		t.Errorf("error expected formatting EUR as NACHA, none received")
+	}
+	for _, s := range []string{"", "0000000001", "000000000001", "0000000001-", "00000 00001", "-0000000001"} {
+		if _, err := ParseBACS(s); err == nil {
+			t.Errorf("error expected from ParseBACS(%q), none received", s)
+		}
+	}
+	if _, err := Parse("GBP", ""); err == nil {
+		t.Errorf("error expected parsing empty field, none received")
+	}
+}
@@ -0,0 +1,118 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// BillShare is one party's portion of a split bill.
+type BillShare struct {
+	Subtotal Money
+	Tax      Money
+	Tip      Money
+	Total    Money
+}
+
+// Bill is a bill split between several parties.
+// The shares of each component sum exactly to that component of the bill.
+type Bill struct {
+	Subtotal Money
+	Tax      Money
+	Tip      Money
+	Total    Money
+	Shares   []BillShare
+}
+
+// SplitBill splits a bill with the given subtotal equally between parties,
+// adding tax and a tip, each calculated as a percentage of the subtotal and rounded half up.
+// Percentages are taken as the shortest decimal that represents them, so 12.3 is exactly 12.3%.
+// Each component is shared equally, with spare pennies handed out in turn, continuing from the
+// party after the one that received the previous component's last spare penny. This keeps each
+// party's total within a penny of every other's.
+// It returns an error if parties is less than 1, or either percentage is negative or not finite,
+// or one wrapping ErrOutOfRange if the total would overflow.
+func SplitBill(subtotal Money, parties int, tipPercent, taxPercent float64) (Bill, error) {
+	if parties < 1 {
+		return Bill{}, fmt.Errorf("can't split a bill between %d parties", parties)
+	}
+	tax, err := percentOf(subtotal, taxPercent, HalfUp)
+	if err != nil {
+		return Bill{}, err
+	}
+	tip, err := percentOf(subtotal, tipPercent, HalfUp)
+	if err != nil {
+		return Bill{}, err
+	}
+	total, err := subtotal.Add(tax)
+	if err == nil {
+		total, err = total.Add(tip)
+	}
+	if err != nil {
+		return Bill{}, err
+	}
+	b := Bill{
+		Subtotal: subtotal,
+		Tax:      tax,
+		Tip:      tip,
+		Total:    total,
+		Shares:   make([]BillShare, parties),
+	}
+	next := 0
+	subs := shareEqually(subtotal, parties, &next)
+	taxes := shareEqually(tax, parties, &next)
+	tips := shareEqually(tip, parties, &next)
+	// A share's components have the same sign as the bill's and are no larger, so its total can't overflow.
+	for i := range b.Shares {
+		b.Shares[i] = BillShare{
+			Subtotal: subs[i],
+			Tax:      taxes[i],
+			Tip:      tips[i],
+			Total:    Money{subtotal.c, subs[i].a + taxes[i].a + tips[i].a},
+		}
+	}
+	return b, nil
+}
+
+// shareEqually shares x between n parties, giving spare pennies in turn starting with party *next.
+// On return, *next is the party due the next spare penny.
+func shareEqually(x Money, n int, next *int) []Money {
+	res := make([]Money, n)
+	base := x.a / n
+	for i := range res {
+		res[i] = Money{x.c, base}
+	}
+	rem := x.a - base*n
+	d := 1
+	if rem < 0 {
+		d = -1
+	}
+	for ; rem != 0; rem -= d {
+		res[*next].a += d
+		*next = (*next + 1) % n
+	}
+	return res
+}
+
+// percentage returns a as a percentage of b, which must be non-zero, calculated exactly before
+// conversion to float64.
+func percentage(a, b int) float64 {
+	r := big.NewRat(int64(a), int64(b))
+	f, _ := r.Mul(r, big.NewRat(100, 1)).Float64()
+	return f
+}
+
+// percentOf returns p% of x, rounded using mode.
+func percentOf(x Money, p float64, mode RoundingMode) (Money, error) {
+	if p < 0 || math.IsNaN(p) || math.IsInf(p, 0) {
+		return Money{}, fmt.Errorf("invalid percentage: %v", p)
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(p, 'f', -1, 64))
+	r.Mul(r, big.NewRat(int64(x.a), 100))
+	a, err := roundAtoms(r, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{x.c, a}, nil
+}
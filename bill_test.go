@@ -0,0 +1,67 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanSplitBill(t *testing.T) {
+	var cases = []struct {
+		subtotal string
+		parties  int
+		tip      float64
+		tax      float64
+		tax_     string
+		tip_     string
+		total    string
+		shares   []string
+	}{
+		{"100.00", 4, 10, 20, "20.00", "10.00", "130.00", []string{"32.50", "32.50", "32.50", "32.50"}},
+		{"100.00", 3, 12.5, 0, "0.00", "12.50", "112.50", []string{"37.50", "37.50", "37.50"}},
+		{"10.00", 3, 0, 0, "0.00", "0.00", "10.00", []string{"3.34", "3.33", "3.33"}},
+		{"10.00", 3, 12.3, 17.5, "1.75", "1.23", "12.98", []string{"4.33", "4.33", "4.32"}},
+		{"0.01", 2, 50, 50, "0.01", "0.01", "0.03", []string{"0.02", "0.01"}},
+		{"59.99", 1, 15, 8.875, "5.32", "9.00", "74.31", []string{"74.31"}},
+	}
+	for _, c := range cases {
+		x, _ := New("GBP", c.subtotal)
+		got, err := SplitBill(x, c.parties, c.tip, c.tax)
+		if err != nil {
+			t.Errorf("error received from SplitBill(%s, %d, %v, %v), none expected %v", c.subtotal, c.parties, c.tip, c.tax, err)
+			continue
+		}
+		if got.Tax.Amount() != c.tax_ || got.Tip.Amount() != c.tip_ || got.Total.Amount() != c.total {
+			t.Errorf("SplitBill(%s, %d, %v, %v): wanted tax %s, tip %s, total %s, got %s, %s, %s", c.subtotal, c.parties, c.tip, c.tax, c.tax_, c.tip_, c.total, got.Tax.Amount(), got.Tip.Amount(), got.Total.Amount())
+		}
+		sum := 0
+		for i, s := range got.Shares {
+			if s.Total.Amount() != c.shares[i] {
+				t.Errorf("SplitBill(%s, %d, %v, %v), share %d: wanted %s, got %s", c.subtotal, c.parties, c.tip, c.tax, i, c.shares[i], s.Total.Amount())
+			}
+			sum += s.Total.a
+		}
+		if sum != got.Total.a {
+			t.Errorf("shares sum to %d, wanted %d", sum, got.Total.a)
+		}
+	}
+}
+
+func TestCanRejectBadBillSplit(t *testing.T) {
+	x, _ := New("GBP", "10.00")
+	if _, err := SplitBill(x, 0, 10, 10); err == nil {
+		t.Errorf("error expected splitting between 0 parties, none received")
+	}
+	if _, err := SplitBill(x, 2, -1, 10); err == nil {
+		t.Errorf("error expected with negative tip, none received")
+	}
+	if _, err := SplitBill(x, 2, 10, -1); err == nil {
+		t.Errorf("error expected with negative tax, none received")
+	}
+}
+
+func TestCanRejectBillSplitOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	if _, err := SplitBill(hi, 2, 10, 0); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("SplitBill(%v, 2, 10, 0): wanted ErrOutOfRange, got %v", hi, err)
+	}
+}
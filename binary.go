@@ -0,0 +1,70 @@
+package dough
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// binaryVersion is the version of the binary encoding written by AppendBinary.
+const binaryVersion = 1
+
+// AppendBinary implements encoding.BinaryAppender, appending the binary encoding of x to b.
+//
+// The encoding is stable and versioned, so values stored in caches and event logs
+// decode correctly after later upgrades. Version 1 is:
+//
+//	byte 0      version, 1
+//	bytes 1-2   ISO 4217 numeric currency code, big-endian
+//	byte 3      exponent: the number of minor unit digits of the amount
+//	bytes 4-    amount in minor units, as a zig-zag varint (see encoding/binary.AppendVarint)
+//
+// The rules for forward compatibility are:
+//   - A change to the meaning or layout of existing fields requires a new version, which
+//     decoders that don't know it reject.
+//   - Within a version, new fields may only be appended after the amount. Decoders ignore
+//     any bytes after the fields they know.
+//   - Decoders rescale the amount to the currency's current exponent, and reject it if that
+//     can't be done exactly, so values survive changes to a currency's minor unit.
+//
+// It returns an error if the currency has no ISO 4217 numeric code.
+func (x Money) AppendBinary(b []byte) ([]byte, error) {
+	n, ok := numericCodes[x.c]
+	if !ok {
+		return nil, fmt.Errorf("couldn't encode Money: %s has no numeric code", x.Currency())
+	}
+	b = append(b, binaryVersion, byte(n>>8), byte(n), byte(x.Exponent()))
+	return binary.AppendVarint(b, int64(x.a)), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using the encoding described at AppendBinary.
+func (x Money) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, accepting the encoding described at AppendBinary.
+func (x *Money) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("couldn't decode Money: no data")
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("couldn't decode Money: unsupported version %d", data[0])
+	}
+	if len(data) < 5 {
+		return fmt.Errorf("couldn't decode Money: expected at least 5 bytes, got %d", len(data))
+	}
+	n := uint16(data[1])<<8 | uint16(data[2])
+	c, ok := currenciesByNumericCode[n]
+	if !ok {
+		return fmt.Errorf("couldn't decode Money: unknown numeric currency code %03d", n)
+	}
+	units, l := binary.Varint(data[4:])
+	if l <= 0 {
+		return fmt.Errorf("couldn't decode Money: invalid amount")
+	}
+	m, err := FromScaled(c.String(), units, int(data[3]))
+	if err != nil {
+		return fmt.Errorf("couldn't decode Money: %v", err)
+	}
+	*x = m
+	return nil
+}
@@ -0,0 +1,86 @@
+package dough
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestCanMarshalBinary(t *testing.T) {
+	var cases = []struct {
+		m    Money
+		want []byte
+	}{
+		{MustNew("GBP", "123.45"), []byte{1, 0x03, 0x3a, 2, 0xf2, 0xc0, 0x01}},
+		{MustNew("GBP", "-0.01"), []byte{1, 0x03, 0x3a, 2, 0x01}},
+		{MustNew("JPY", "0"), []byte{1, 0x01, 0x88, 0, 0x00}},
+		{MustNew("KWD", "1.000"), []byte{1, 0x01, 0x9e, 3, 0xd0, 0x0f}},
+		{Money{MustNew("USD", "0.00").c, math.MaxInt64}, []byte{1, 0x03, 0x48, 2, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, c := range cases {
+		got, err := c.m.MarshalBinary()
+		if err != nil {
+			t.Errorf("error received from %v.MarshalBinary(), none expected %v", c.m, err)
+			continue
+		}
+		if !bytes.Equal(got, c.want) {
+			t.Errorf("%v.MarshalBinary(): wanted % x, got % x", c.m, c.want, got)
+		}
+		var m Money
+		if err := m.UnmarshalBinary(got); err != nil || m != c.m {
+			t.Errorf("UnmarshalBinary(% x): wanted %v, got %v (%v)", got, c.m, m, err)
+		}
+	}
+}
+
+func TestCanUnmarshalBinaryCompatibly(t *testing.T) {
+	var cases = []struct {
+		data []byte
+		want string
+	}{
+		{[]byte{1, 0x03, 0x3a, 2, 0x02}, "GBP 0.01"},
+		{[]byte{1, 0x03, 0x3a, 2, 0x02, 0xff, 0x00}, "GBP 0.01"},
+		{[]byte{1, 0x03, 0x3a, 4, 0xd0, 0x0f}, "GBP 0.10"},
+		{[]byte{1, 0x03, 0x3a, 0, 0x02}, "GBP 1.00"},
+	}
+	for _, c := range cases {
+		var m Money
+		if err := m.UnmarshalBinary(c.data); err != nil || m.String() != c.want {
+			t.Errorf("UnmarshalBinary(% x): wanted %s, got %v (%v)", c.data, c.want, m, err)
+		}
+	}
+}
+
+func TestCanRejectBadBinary(t *testing.T) {
+	var cases = [][]byte{
+		nil,
+		{2, 0x03, 0x3a, 2, 0x02},
+		{0, 0x03, 0x3a, 2, 0x02},
+		{1, 0x03, 0x3a, 2},
+		{1, 0x00, 0x00, 2, 0x02},
+		{1, 0x03, 0x3a, 2, 0x80},
+		{1, 0x03, 0x3a, 3, 0x02},
+	}
+	for _, data := range cases {
+		var m Money
+		if err := m.UnmarshalBinary(data); err == nil {
+			t.Errorf("error expected from UnmarshalBinary(% x), none received, got %v", data, m)
+		}
+	}
+}
+
+func TestCanGobEncode(t *testing.T) {
+	type line struct {
+		Price Money
+	}
+	var b bytes.Buffer
+	in := line{MustNew("EUR", "9.99")}
+	if err := gob.NewEncoder(&b).Encode(in); err != nil {
+		t.Fatalf("error received from gob Encode, none expected %v", err)
+	}
+	var out line
+	if err := gob.NewDecoder(&b).Decode(&out); err != nil || out != in {
+		t.Errorf("gob round trip: wanted %v, got %v (%v)", in, out, err)
+	}
+}
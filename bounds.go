@@ -0,0 +1,101 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/text/currency"
+)
+
+// ErrOutOfRange is returned, wrapped, when an amount is too large in magnitude to be represented,
+// either because it was given that way or because arithmetic would overflow.
+var ErrOutOfRange = errors.New("amount out of range")
+
+// maxAtoms is the largest number of minor units a Money can hold.
+// The range is symmetric, so that negating a Money can never overflow.
+const maxAtoms = math.MaxInt
+
+// MaxValue returns the largest Money representable in the given currency,
+// e.g. GBP 92233720368547758.07 where int is 64 bits.
+// It returns an error if cur is not well formed or not recognised.
+func MaxValue(cur string) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	return Money{c, maxAtoms}, nil
+}
+
+// MinValue returns the smallest Money representable in the given currency,
+// which is the negation of MaxValue.
+// It returns an error if cur is not well formed or not recognised.
+func MinValue(cur string) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	return Money{c, -maxAtoms}, nil
+}
+
+// addAtoms returns a+b, with ok false if the result is outside ±maxAtoms.
+func addAtoms(a, b int) (z int, ok bool) {
+	if b > 0 && a > maxAtoms-b || b < 0 && a < -maxAtoms-b {
+		return 0, false
+	}
+	return a + b, true
+}
+
+// mulAtoms returns a×f, with ok false if the result is outside ±maxAtoms.
+func mulAtoms(a, f int) (z int, ok bool) {
+	if a == 0 || f == 0 {
+		return 0, true
+	}
+	z = a * f
+	if z/f != a || z == math.MinInt || f == -1 && a == math.MinInt {
+		return 0, false
+	}
+	return z, true
+}
+
+// AddSaturating is like Add, but clamps the result to MinValue or MaxValue instead of overflowing.
+// It still returns an error if the currencies differ.
+func (x Money) AddSaturating(y Money) (Money, error) {
+	return addSubSaturating(x, y, true)
+}
+
+// SubSaturating is like Sub, but clamps the result to MinValue or MaxValue instead of overflowing.
+// It still returns an error if the currencies differ.
+func (x Money) SubSaturating(y Money) (Money, error) {
+	return addSubSaturating(x, y, false)
+}
+
+func addSubSaturating(x, y Money, add bool) (Money, error) {
+	z, err := addSub(x, y, add)
+	if !errors.Is(err, ErrOutOfRange) {
+		return z, err
+	}
+	// Overflow is only possible when the result has the sign of x.
+	return Money{x.c, saturate(x.a)}, nil
+}
+
+// MulSaturating is like Mul, but clamps the result to MinValue or MaxValue instead of overflowing.
+func (x Money) MulSaturating(f int) Money {
+	z, ok := mulAtoms(x.a, f)
+	if !ok {
+		if f < 0 {
+			z = saturate(-x.a)
+		} else {
+			z = saturate(x.a)
+		}
+	}
+	return Money{x.c, z}
+}
+
+// saturate returns the bound with the same sign as a.
+func saturate(a int) int {
+	if a < 0 {
+		return -maxAtoms
+	}
+	return maxAtoms
+}
@@ -0,0 +1,99 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestCanGetBounds(t *testing.T) {
+	hi, err := MaxValue("GBP")
+	if err != nil || hi.MinorUnits() != math.MaxInt {
+		t.Errorf("MaxValue(GBP): wanted %d minor units, got %v (%v)", math.MaxInt, hi, err)
+	}
+	lo, err := MinValue("GBP")
+	if err != nil || lo.MinorUnits() != -math.MaxInt {
+		t.Errorf("MinValue(GBP): wanted %d minor units, got %v (%v)", -math.MaxInt, lo, err)
+	}
+	if got, err := New("GBP", hi.Amount()); err != nil || got != hi {
+		t.Errorf("New(GBP, %s): wanted %v, got %v (%v)", hi.Amount(), hi, got, err)
+	}
+	if got, err := New("GBP", lo.Amount()); err != nil || got != lo {
+		t.Errorf("New(GBP, %s): wanted %v, got %v (%v)", lo.Amount(), lo, got, err)
+	}
+	if _, err := MaxValue("FOO"); err == nil {
+		t.Errorf("error expected from MaxValue with bad currency, none received")
+	}
+	if _, err := MinValue("FOO"); err == nil {
+		t.Errorf("error expected from MinValue with bad currency, none received")
+	}
+}
+
+func TestCanRejectOutOfRange(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	lo, _ := MinValue("GBP")
+	one := gbp("0.01")
+	tooBig := strconv.FormatUint(math.MaxInt+1, 10)
+	var cases = []struct {
+		name string
+		f    func() (Money, error)
+	}{
+		{"New too large", func() (Money, error) { return New("JPY", tooBig) }},
+		{"New too small", func() (Money, error) { return New("JPY", "-"+tooBig) }},
+		{"New too many digits", func() (Money, error) { return New("GBP", "99999999999999999999.00") }},
+		{"FromMinorUnits MinInt64", func() (Money, error) { return FromMinorUnits("GBP", math.MinInt64) }},
+		{"FromScaled", func() (Money, error) { return FromScaled("GBP", math.MaxInt64/2, 0) }},
+		{"Add", func() (Money, error) { return hi.Add(one) }},
+		{"Sub", func() (Money, error) { return lo.Sub(one) }},
+		{"Mul", func() (Money, error) { return hi.Mul(2) }},
+		{"Mul negative", func() (Money, error) { return lo.Mul(2) }},
+	}
+	for _, c := range cases {
+		got, err := c.f()
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("%s: wanted ErrOutOfRange, got %v (%v)", c.name, got, err)
+		}
+	}
+	if got, err := hi.Mul(-1); err != nil || got != lo {
+		t.Errorf("MaxValue.Mul(-1): wanted %v, got %v (%v)", lo, got, err)
+	}
+	if got, err := hi.Add(lo); err != nil || got.MinorUnits() != 0 {
+		t.Errorf("MaxValue.Add(MinValue): wanted 0, got %v (%v)", got, err)
+	}
+}
+
+func TestCanSaturate(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	lo, _ := MinValue("GBP")
+	var cases = []struct {
+		name string
+		f    func() (Money, error)
+		want Money
+	}{
+		{"Add in range", func() (Money, error) { return gbp("1.00").AddSaturating(gbp("2.00")) }, gbp("3.00")},
+		{"Add over", func() (Money, error) { return hi.AddSaturating(gbp("0.01")) }, hi},
+		{"Add under", func() (Money, error) { return lo.AddSaturating(gbp("-5.00")) }, lo},
+		{"Sub in range", func() (Money, error) { return gbp("1.00").SubSaturating(gbp("2.00")) }, gbp("-1.00")},
+		{"Sub over", func() (Money, error) { return hi.SubSaturating(gbp("-0.01")) }, hi},
+		{"Sub under", func() (Money, error) { return lo.SubSaturating(hi) }, lo},
+		{"Mul in range", func() (Money, error) { return gbp("1.50").MulSaturating(3), nil }, gbp("4.50")},
+		{"Mul over", func() (Money, error) { return hi.MulSaturating(2), nil }, hi},
+		{"Mul under", func() (Money, error) { return hi.MulSaturating(-2), nil }, lo},
+		{"Mul negative over", func() (Money, error) { return lo.MulSaturating(-3), nil }, hi},
+		{"Mul zero", func() (Money, error) { return hi.MulSaturating(0), nil }, gbp("0.00")},
+	}
+	for _, c := range cases {
+		got, err := c.f()
+		if err != nil || got != c.want {
+			t.Errorf("%s: wanted %v, got %v (%v)", c.name, c.want, got, err)
+		}
+	}
+	eur, _ := New("EUR", "1.00")
+	if _, err := hi.AddSaturating(eur); err == nil {
+		t.Errorf("error expected from AddSaturating with different currencies, none received")
+	}
+	if _, err := hi.SubSaturating(eur); err == nil {
+		t.Errorf("error expected from SubSaturating with different currencies, none received")
+	}
+}
@@ -0,0 +1,140 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+)
+
+// EnvelopeSpec describes how much of a Budget's income goes to an envelope.
+// An envelope gets either a Fixed amount, if non-zero, or a share of what's left
+// after fixed amounts in proportion to its Weight.
+type EnvelopeSpec struct {
+	Name   string
+	Fixed  Money
+	Weight uint
+}
+
+// Envelope is a named category of a Budget, e.g. "rent" or "groceries".
+type Envelope struct {
+	Name      string
+	Allocated Money
+	Spent     Money
+}
+
+// Remaining returns what's left to spend in the envelope. It is negative if the envelope is overspent.
+// It returns an error wrapping ErrOutOfRange if the result would overflow, e.g. after large refunds.
+func (e Envelope) Remaining() (Money, error) {
+	return e.Allocated.Sub(e.Spent)
+}
+
+// Budget allocates an income across envelopes and tracks spending against each.
+type Budget struct {
+	income      Money
+	unallocated Money
+	envelopes   []Envelope
+	index       map[string]int
+}
+
+// NewBudget returns a new Budget allocating income across envelopes as given by specs.
+// Fixed amounts are allocated first, then the rest is shared exactly between the weighted envelopes,
+// with spare pennies going to envelopes from first to last. If no envelope has a weight,
+// whatever isn't allocated to fixed amounts is left unallocated.
+// It returns an error if income is negative, names are empty or repeated, fixed amounts are
+// negative or in a different currency, fixed amounts total more than income, or a weight is greater
+// than math.MaxInt64, or one wrapping ErrOutOfRange if their total would overflow.
+func NewBudget(income Money, specs []EnvelopeSpec) (*Budget, error) {
+	if income.a < 0 {
+		return nil, fmt.Errorf("can't budget negative income %v", income)
+	}
+	b := &Budget{
+		income:    income,
+		envelopes: make([]Envelope, len(specs)),
+		index:     map[string]int{},
+	}
+	rem := income.a
+	weights := make([]int64, len(specs))
+	weighted := false
+	for i, s := range specs {
+		if s.Name == "" {
+			return nil, fmt.Errorf("envelope %d has no name", i)
+		}
+		if _, ok := b.index[s.Name]; ok {
+			return nil, fmt.Errorf("envelope %q appears more than once", s.Name)
+		}
+		b.index[s.Name] = i
+		b.envelopes[i] = Envelope{Name: s.Name, Allocated: Money{income.c, 0}, Spent: Money{income.c, 0}}
+		if s.Fixed.a != 0 {
+			if s.Fixed.Currency() != income.Currency() {
+				return nil, fmt.Errorf("Can't budget %s for envelope %q from %s income", s.Fixed.Currency(), s.Name, income.Currency())
+			}
+			if s.Fixed.a < 0 {
+				return nil, fmt.Errorf("envelope %q has negative amount %v", s.Name, s.Fixed)
+			}
+			b.envelopes[i].Allocated.a = s.Fixed.a
+			var ok bool
+			if rem, ok = addAtoms(rem, -s.Fixed.a); !ok {
+				return nil, fmt.Errorf("%w: fixed amounts of %v budget", ErrOutOfRange, income)
+			}
+			continue
+		}
+		if uint64(s.Weight) > math.MaxInt64 {
+			return nil, fmt.Errorf("envelope %q weight too large: %d", s.Name, s.Weight)
+		}
+		weights[i] = int64(s.Weight)
+		weighted = weighted || s.Weight > 0
+	}
+	if rem < 0 {
+		return nil, fmt.Errorf("fixed amounts exceed income of %v by %s", income, Money{income.c, -rem}.Amount())
+	}
+	if weighted && rem > 0 {
+		for i, a := range allocate(rem, weights) {
+			b.envelopes[i].Allocated.a += a
+		}
+		rem = 0
+	}
+	b.unallocated = Money{income.c, rem}
+	return b, nil
+}
+
+// Income gets the income the Budget was created with.
+func (b *Budget) Income() Money {
+	return b.income
+}
+
+// Unallocated gets the part of the income not allocated to any envelope.
+func (b *Budget) Unallocated() Money {
+	return b.unallocated
+}
+
+// Envelope returns the envelope with the given name.
+func (b *Budget) Envelope(name string) (Envelope, bool) {
+	i, ok := b.index[name]
+	if !ok {
+		return Envelope{}, false
+	}
+	return b.envelopes[i], true
+}
+
+// Envelopes returns the envelopes, in the order they were specified.
+func (b *Budget) Envelopes() []Envelope {
+	return append([]Envelope(nil), b.envelopes...)
+}
+
+// Spend records m spent from the named envelope. Envelopes may be overspent; a negative m records a refund.
+// It returns an error if there's no such envelope, or m is in a different currency, or one wrapping
+// ErrOutOfRange if the envelope's spending would overflow.
+func (b *Budget) Spend(name string, m Money) error {
+	i, ok := b.index[name]
+	if !ok {
+		return fmt.Errorf("no envelope %q", name)
+	}
+	if m.Currency() != b.income.Currency() {
+		return fmt.Errorf("Can't spend %s from %s budget", m.Currency(), b.income.Currency())
+	}
+	spent, err := b.envelopes[i].Spent.Add(m)
+	if err != nil {
+		return err
+	}
+	b.envelopes[i].Spent = spent
+	return nil
+}
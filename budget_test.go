@@ -0,0 +1,130 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanAllocateBudget(t *testing.T) {
+	var cases = []struct {
+		income      string
+		specs       []EnvelopeSpec
+		want        []string
+		unallocated string
+	}{
+		{"1000.00", []EnvelopeSpec{{Name: "rent", Fixed: gbp("600.00")}, {Name: "food", Weight: 1}, {Name: "fun", Weight: 1}}, []string{"600.00", "200.00", "200.00"}, "0.00"},
+		{"100.00", []EnvelopeSpec{{Name: "a", Weight: 1}, {Name: "b", Weight: 1}, {Name: "c", Weight: 1}}, []string{"33.34", "33.33", "33.33"}, "0.00"},
+		{"100.00", []EnvelopeSpec{{Name: "a", Weight: 3}, {Name: "b", Weight: 1}}, []string{"75.00", "25.00"}, "0.00"},
+		{"100.00", []EnvelopeSpec{{Name: "a", Fixed: gbp("40.00")}, {Name: "b"}}, []string{"40.00", "0.00"}, "60.00"},
+		{"100.00", []EnvelopeSpec{{Name: "a", Fixed: gbp("100.00")}, {Name: "b", Weight: 1}}, []string{"100.00", "0.00"}, "0.00"},
+		{"0.00", nil, nil, "0.00"},
+	}
+	for _, c := range cases {
+		b, err := NewBudget(gbp(c.income), c.specs)
+		if err != nil {
+			t.Errorf("error received from NewBudget(%s, %v), none expected %v", c.income, c.specs, err)
+			continue
+		}
+		for i, e := range b.Envelopes() {
+			if e.Allocated.Amount() != c.want[i] {
+				t.Errorf("NewBudget(%s), envelope %q: wanted %s, got %s", c.income, e.Name, c.want[i], e.Allocated.Amount())
+			}
+		}
+		if b.Unallocated().Amount() != c.unallocated {
+			t.Errorf("NewBudget(%s): wanted unallocated %s, got %s", c.income, c.unallocated, b.Unallocated().Amount())
+		}
+	}
+}
+
+func TestCanTrackBudgetSpending(t *testing.T) {
+	b, _ := NewBudget(gbp("300.00"), []EnvelopeSpec{{Name: "food", Weight: 2}, {Name: "fun", Weight: 1}})
+	for _, s := range []struct {
+		name string
+		amt  string
+	}{
+		{"food", "150.00"},
+		{"food", "-10.00"},
+		{"fun", "120.00"},
+	} {
+		if err := b.Spend(s.name, gbp(s.amt)); err != nil {
+			t.Errorf("error received from Spend(%s, %s), none expected %v", s.name, s.amt, err)
+		}
+	}
+	if e, _ := b.Envelope("food"); e.Spent.Amount() != "140.00" {
+		t.Errorf("food: wanted spent 140.00, got %s", e.Spent.Amount())
+	} else if r, err := e.Remaining(); err != nil || r.Amount() != "60.00" {
+		t.Errorf("food: wanted remaining 60.00, got %v (%v)", r, err)
+	}
+	fun, _ := b.Envelope("fun")
+	if r, err := fun.Remaining(); err != nil || r.Amount() != "-20.00" {
+		t.Errorf("fun: wanted remaining -20.00, got %v (%v)", r, err)
+	}
+	if err := b.Spend("rent", gbp("1.00")); err == nil {
+		t.Errorf("error expected spending from unknown envelope, none received")
+	}
+	if err := b.Spend("food", MustNew("EUR", "1.00")); err == nil {
+		t.Errorf("error expected spending EUR, none received")
+	}
+	if _, ok := b.Envelope("rent"); ok {
+		t.Errorf("wanted no rent envelope")
+	}
+}
+
+func TestCanRejectBadBudget(t *testing.T) {
+	var cases = []struct {
+		income string
+		specs  []EnvelopeSpec
+	}{
+		{"-1.00", nil},
+		{"100.00", []EnvelopeSpec{{Weight: 1}}},
+		{"100.00", []EnvelopeSpec{{Name: "a", Weight: 1}, {Name: "a", Weight: 1}}},
+		{"100.00", []EnvelopeSpec{{Name: "a", Fixed: MustNew("EUR", "1.00")}}},
+		{"100.00", []EnvelopeSpec{{Name: "a", Fixed: gbp("-1.00")}}},
+		{"100.00", []EnvelopeSpec{{Name: "a", Fixed: gbp("60.00")}, {Name: "b", Fixed: gbp("40.01")}}},
+		{"100.00", []EnvelopeSpec{{Name: "a", Weight: ^uint(0)}, {Name: "b", Weight: 5}}},
+	}
+	for _, c := range cases {
+		if _, err := NewBudget(gbp(c.income), c.specs); err == nil {
+			t.Errorf("error expected from NewBudget(%s, %v), none received", c.income, c.specs)
+		}
+	}
+}
+
+func TestCanRejectBudgetOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	specs := []EnvelopeSpec{{Name: "a", Fixed: hi}, {Name: "b", Fixed: hi}, {Name: "c", Fixed: hi}}
+	if _, err := NewBudget(hi, specs); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("NewBudget with fixed amounts totalling 3 × %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	b, _ := NewBudget(gbp("1.00"), []EnvelopeSpec{{Name: "a", Weight: 1}})
+	if err := b.Spend("a", hi); err != nil {
+		t.Fatalf("error received from Spend, none expected %v", err)
+	}
+	if err := b.Spend("a", hi); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Spend of 2 × %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if e, _ := b.Envelope("a"); e.Spent != hi {
+		t.Errorf("wanted spending unchanged by failed Spend, got %v", e.Spent)
+	}
+	lo, _ := MinValue("GBP")
+	b, _ = NewBudget(hi, []EnvelopeSpec{{Name: "a", Weight: 1}})
+	if err := b.Spend("a", lo); err != nil {
+		t.Fatalf("error received from Spend, none expected %v", err)
+	}
+	e, _ := b.Envelope("a")
+	if r, err := e.Remaining(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Remaining of %v less %v: wanted ErrOutOfRange, got %v", e.Allocated, e.Spent, r)
+	}
+}
+
+func TestCanShareBudgetBetweenLargeWeights(t *testing.T) {
+	b, err := NewBudget(gbp("100.00"), []EnvelopeSpec{{Name: "a", Weight: 1 << 62}, {Name: "b", Weight: 1 << 62}, {Name: "c", Weight: 1 << 62}})
+	if err != nil {
+		t.Fatalf("error received from NewBudget, none expected %v", err)
+	}
+	for i, want := range []string{"33.34", "33.33", "33.33"} {
+		if got := b.Envelopes()[i].Allocated.Amount(); got != want {
+			t.Errorf("envelope %d: wanted %s, got %s", i, want, got)
+		}
+	}
+}
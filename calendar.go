@@ -0,0 +1,121 @@
+package dough
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/text/currency"
+)
+
+// Calendar determines which dates are business days in a currency's market.
+// Only the date of each time.Time is significant.
+type Calendar interface {
+	IsBusinessDay(date time.Time) bool
+}
+
+// CalendarFunc is an adapter to allow the use of ordinary functions as Calendars.
+type CalendarFunc func(date time.Time) bool
+
+// IsBusinessDay implements Calendar by calling f.
+func (f CalendarFunc) IsBusinessDay(date time.Time) bool {
+	return f(date)
+}
+
+// Weekdays is a Calendar in which Monday to Friday are business days. It is used for any currency
+// without a registered calendar.
+var Weekdays Calendar = NewHolidayCalendar(nil)
+
+type holidayCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[time.Time]bool
+}
+
+// NewHolidayCalendar returns a Calendar in which every day is a business day except for those
+// of the weekend and holidays. A nil weekend is Saturday and Sunday.
+func NewHolidayCalendar(weekend []time.Weekday, holidays ...time.Time) Calendar {
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	c := &holidayCalendar{
+		make(map[time.Weekday]bool, len(weekend)),
+		make(map[time.Time]bool, len(holidays)),
+	}
+	for _, d := range weekend {
+		c.weekend[d] = true
+	}
+	for _, h := range holidays {
+		c.holidays[day(h)] = true
+	}
+	return c
+}
+
+// IsBusinessDay implements Calendar.
+func (c *holidayCalendar) IsBusinessDay(date time.Time) bool {
+	return !c.weekend[date.Weekday()] && !c.holidays[day(date)]
+}
+
+var calendars = struct {
+	sync.RWMutex
+	m map[currency.Unit]Calendar
+}{
+	m: map[currency.Unit]Calendar{},
+}
+
+// RegisterCalendar sets the calendar of business days for the currency cur, replacing any
+// existing one.
+// It returns an error if cur is not well formed or not recognised.
+func RegisterCalendar(cur string, c Calendar) error {
+	u, err := currency.ParseISO(cur)
+	if err != nil {
+		return fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	calendars.Lock()
+	defer calendars.Unlock()
+	calendars.m[u] = c
+	return nil
+}
+
+// LookupCalendar returns the calendar registered for the currency cur, or Weekdays if there isn't one.
+// ok reports whether a calendar was registered.
+func LookupCalendar(cur string) (c Calendar, ok bool) {
+	u, err := currency.ParseISO(cur)
+	if err != nil {
+		return Weekdays, false
+	}
+	calendars.RLock()
+	defer calendars.RUnlock()
+	if c, ok := calendars.m[u]; ok {
+		return c, true
+	}
+	return Weekdays, false
+}
+
+// maxNonBusinessDays limits how far EffectiveDate looks back for a business day.
+const maxNonBusinessDays = 366
+
+// EffectiveDate returns the latest date on or before date that is a business day in the markets
+// of all of currs, according to their calendars, e.g. the date whose rate is in effect on a Sunday.
+// It returns an error if any currency is not well formed or not recognised, or if there is no such
+// date in the preceding year.
+func EffectiveDate(date time.Time, currs ...string) (time.Time, error) {
+	cals := make([]Calendar, len(currs))
+	for i, cur := range currs {
+		if _, err := currency.ParseISO(cur); err != nil {
+			return time.Time{}, fmt.Errorf("coudn't parse currency: %v", err)
+		}
+		cals[i], _ = LookupCalendar(cur)
+	}
+	d := day(date)
+	for n := 0; n <= maxNonBusinessDays; n++ {
+		open := true
+		for _, c := range cals {
+			open = open && c.IsBusinessDay(d)
+		}
+		if open {
+			return d, nil
+		}
+		d = d.AddDate(0, 0, -1)
+	}
+	return time.Time{}, fmt.Errorf("no business day for %v in the year to %s", currs, day(date).Format("2006-01-02"))
+}
@@ -0,0 +1,88 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+func registerTestCalendars() {
+	RegisterCalendar("CHF", NewHolidayCalendar(nil, date("2024-01-01"), date("2024-01-02")))
+	RegisterCalendar("SAR", NewHolidayCalendar([]time.Weekday{time.Friday, time.Saturday}))
+	RegisterCalendar("ISK", CalendarFunc(func(time.Time) bool { return false }))
+}
+
+func TestCanFindEffectiveDate(t *testing.T) {
+	registerTestCalendars()
+	var cases = []struct {
+		date  string
+		currs []string
+		want  string
+	}{
+		{"2024-01-05", []string{"USD"}, "2024-01-05"},
+		{"2024-01-06", []string{"USD"}, "2024-01-05"},
+		{"2024-01-07", []string{"USD", "GBP"}, "2024-01-05"},
+		{"2024-01-02", []string{"USD"}, "2024-01-02"},
+		{"2024-01-02", []string{"CHF"}, "2023-12-29"},
+		{"2024-01-02", []string{"GBP", "CHF"}, "2023-12-29"},
+		{"2024-01-06", []string{"SAR"}, "2024-01-04"},
+		{"2024-01-07", []string{"SAR"}, "2024-01-07"},
+		{"2024-01-07", []string{"SAR", "USD"}, "2024-01-04"},
+		{"2024-01-07", nil, "2024-01-07"},
+	}
+	for _, c := range cases {
+		got, err := EffectiveDate(date(c.date), c.currs...)
+		if err != nil || got.Format("2006-01-02") != c.want {
+			t.Errorf("EffectiveDate(%s, %v): wanted %s, got %v (%v)", c.date, c.currs, c.want, got, err)
+		}
+	}
+	if _, err := EffectiveDate(date("2024-01-07"), "ISK"); err == nil {
+		t.Errorf("error expected from EffectiveDate with no business days, none received")
+	}
+	if _, err := EffectiveDate(date("2024-01-07"), "FOO"); err == nil {
+		t.Errorf("error expected from EffectiveDate with bad currency, none received")
+	}
+}
+
+func TestCanRegisterCalendar(t *testing.T) {
+	registerTestCalendars()
+	if c, ok := LookupCalendar("SAR"); !ok || c.IsBusinessDay(date("2024-01-05")) {
+		t.Errorf("wanted registered SAR calendar with Friday closed, got %v (%t)", c, ok)
+	}
+	if c, ok := LookupCalendar("JPY"); ok || c != Weekdays {
+		t.Errorf("wanted Weekdays for JPY, got %v (%t)", c, ok)
+	}
+	if err := RegisterCalendar("FOO", Weekdays); err == nil {
+		t.Errorf("error expected from RegisterCalendar with bad currency, none received")
+	}
+	if Weekdays.IsBusinessDay(date("2024-01-06")) || !Weekdays.IsBusinessDay(date("2024-01-08")) {
+		t.Errorf("wanted Weekdays to exclude Saturday and include Monday")
+	}
+}
+
+func TestCanLookUpEffectiveRate(t *testing.T) {
+	registerTestCalendars()
+	h := &RateHistory{}
+	dec, _ := ParseRate("GBP", "CHF", "1.07")
+	jan, _ := ParseRate("GBP", "CHF", "1.08")
+	h.Set(date("2023-12-29"), []Rate{dec})
+	h.Set(date("2024-01-02"), []Rate{jan})
+	if got, err := h.RateOn(date("2024-01-02"), "GBP", "CHF", RateStrict); err != nil || got.RatString() != "27/25" {
+		t.Errorf("wanted 27/25 on 2024-01-02, got %v (%v)", got, err)
+	}
+	// 2024-01-02 is a holiday in Switzerland, so the rate in effect is from 2023-12-29.
+	if got, err := h.RateOn(date("2024-01-02"), "GBP", "CHF", RateEffective); err != nil || got.RatString() != "107/100" {
+		t.Errorf("wanted 107/100 in effect on 2024-01-02, got %v (%v)", got, err)
+	}
+	if _, err := h.RateOn(date("2024-01-03"), "GBP", "CHF", RateEffective); !errors.Is(err, ErrNoRateOnDate) {
+		t.Errorf("wanted ErrNoRateOnDate for missing rate on business day, got %v", err)
+	}
+	if _, err := h.RateOn(date("2024-01-03"), "GBP", "ISK", RateEffective); err == nil || errors.Is(err, ErrNoRateOnDate) {
+		t.Errorf("wanted calendar error for currency with no business days, got %v", err)
+	}
+}
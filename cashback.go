@@ -0,0 +1,110 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CashbackTier is a band of spending within a period earning cashback at Rate.
+type CashbackTier struct {
+	// UpTo is the cumulative spend in the period at which the tier ends.
+	// The zero Money means the tier is unbounded; only the last tier may be.
+	UpTo Money
+	Rate Proportion
+}
+
+// Cashback is a cashback scheme with tiered rates and a cap per period, e.g. a month.
+// Tiers are marginal, like tax bands: each tier's rate applies only to the spend within it.
+type Cashback struct {
+	// Tiers are in ascending order of UpTo.
+	Tiers []CashbackTier
+	// Cap is the most cashback that can be earned in a period. The zero Money means no cap.
+	Cap Money
+	// Rounding rounds the cashback to the currency's minor unit.
+	Rounding RoundingMode
+}
+
+// CashbackReward is the cashback earned on a transaction.
+type CashbackReward struct {
+	Reward Money
+	// CapRemaining is the cashback that can still be earned in the period, after Reward.
+	// It is the zero Money if there is no cap.
+	CapRemaining Money
+}
+
+// Reward returns the cashback earned on spend, given the spend and cashback already in the period.
+// The cashback for the period so far is calculated exactly and rounded, and the reward is the
+// increase in that, so the rewards in a period sum to the rounded cashback on the total spend,
+// up to the cap.
+// It returns an error if the amounts aren't all in the same currency, any is negative,
+// the tiers aren't ascending with only the last unbounded, or an amount can't be represented.
+func (cb Cashback) Reward(spend, spentBefore, earnedBefore Money) (CashbackReward, error) {
+	amounts := []Money{spend, spentBefore, earnedBefore}
+	if cb.Cap.a != 0 {
+		amounts = append(amounts, cb.Cap)
+	}
+	for _, y := range amounts {
+		if y.c != spend.c {
+			return CashbackReward{}, fmt.Errorf("Can't calculate cashback on different currencies (%s and %s)", spend.Currency(), y.Currency())
+		}
+		if y.a < 0 {
+			return CashbackReward{}, fmt.Errorf("cashback amounts must not be negative, got %v", y)
+		}
+	}
+	prev := 0
+	for i, t := range cb.Tiers {
+		last := i == len(cb.Tiers)-1
+		if t.UpTo.a == 0 && last {
+			continue
+		}
+		if t.UpTo.c != spend.c || t.UpTo.a <= prev {
+			return CashbackReward{}, fmt.Errorf("cashback tier %d must end above %s %s, got %v", i, spend.Currency(), Money{spend.c, prev}.Amount(), t.UpTo)
+		}
+		prev = t.UpTo.a
+	}
+	after, err := spentBefore.Add(spend)
+	if err != nil {
+		return CashbackReward{}, err
+	}
+	before, err := roundAtoms(cb.exact(spentBefore.a), cb.Rounding)
+	if err != nil {
+		return CashbackReward{}, err
+	}
+	total, err := roundAtoms(cb.exact(after.a), cb.Rounding)
+	if err != nil {
+		return CashbackReward{}, err
+	}
+	r := CashbackReward{Reward: Money{spend.c, total - before}}
+	if cb.Cap.a != 0 {
+		left := cb.Cap.a - earnedBefore.a
+		if left < 0 {
+			left = 0
+		}
+		if r.Reward.a > left {
+			r.Reward.a = left
+		}
+		r.CapRemaining = Money{spend.c, left - r.Reward.a}
+	}
+	return r, nil
+}
+
+// exact returns the exact cashback, in minor units, on a spend of a minor units in a period.
+func (cb Cashback) exact(a int) *big.Rat {
+	sum := new(big.Rat)
+	lo := 0
+	for _, t := range cb.Tiers {
+		hi := t.UpTo.a
+		if hi == 0 || hi > a {
+			hi = a
+		}
+		if hi > lo {
+			r := t.Rate.Rat()
+			sum.Add(sum, r.Mul(r, big.NewRat(int64(hi-lo), 1)))
+			lo = hi
+		}
+		if lo >= a {
+			break
+		}
+	}
+	return sum
+}
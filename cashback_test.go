@@ -0,0 +1,85 @@
+package dough
+
+import "testing"
+
+func testCashback() Cashback {
+	pct := func(p float64) Proportion {
+		r, _ := NewPercent(p)
+		return r
+	}
+	return Cashback{
+		Tiers: []CashbackTier{
+			{gbp("100.00"), pct(0.5)},
+			{gbp("500.00"), pct(1)},
+			{Money{}, pct(1.25)},
+		},
+		Cap:      gbp("10.00"),
+		Rounding: Down,
+	}
+}
+
+func TestCanCalculateCashback(t *testing.T) {
+	cb := testCashback()
+	var cases = []struct {
+		spend, spentBefore, earnedBefore Money
+		reward, remaining                Money
+	}{
+		{gbp("50.00"), gbp("0.00"), gbp("0.00"), gbp("0.25"), gbp("9.75")},
+		// £80 to £200 spans the first two tiers: 0.5% of £20 plus 1% of £100.
+		{gbp("120.00"), gbp("80.00"), gbp("0.40"), gbp("1.10"), gbp("8.50")},
+		{gbp("100.00"), gbp("500.00"), gbp("4.50"), gbp("1.25"), gbp("4.25")},
+		{gbp("1000.00"), gbp("500.00"), gbp("4.50"), gbp("5.50"), gbp("0.00")},
+		{gbp("100.00"), gbp("1500.00"), gbp("10.00"), gbp("0.00"), gbp("0.00")},
+		{gbp("0.00"), gbp("0.00"), gbp("0.00"), gbp("0.00"), gbp("10.00")},
+	}
+	for i, c := range cases {
+		got, err := cb.Reward(c.spend, c.spentBefore, c.earnedBefore)
+		if err != nil || got.Reward != c.reward || got.CapRemaining != c.remaining {
+			t.Errorf("%d: wanted %v (%v remaining), got %+v (%v)", i, c.reward, c.remaining, got, err)
+		}
+	}
+}
+
+func TestCanAccumulateCashbackWithoutDrift(t *testing.T) {
+	cb := testCashback()
+	cb.Cap = Money{}
+	spent, earned := gbp("0.00"), gbp("0.00")
+	// 0.5% of 99p is 0.495p; rounding each transaction down would earn nothing.
+	for i := 0; i < 100; i++ {
+		r, err := cb.Reward(gbp("0.99"), spent, earned)
+		if err != nil {
+			t.Fatalf("error received from Reward, none expected %v", err)
+		}
+		if r.CapRemaining != (Money{}) {
+			t.Errorf("wanted no cap remaining without a cap, got %v", r.CapRemaining)
+		}
+		spent, _ = spent.Add(gbp("0.99"))
+		earned, _ = earned.Add(r.Reward)
+	}
+	if earned != gbp("0.49") {
+		t.Errorf("wanted 0.49 earned on 100 × 0.99, got %v", earned)
+	}
+}
+
+func TestCanRejectBadCashback(t *testing.T) {
+	eur, _ := New("EUR", "1.00")
+	cb := testCashback()
+	bad := Cashback{Tiers: []CashbackTier{{gbp("100.00"), Proportion{}}, {gbp("50.00"), Proportion{}}}}
+	unbounded := Cashback{Tiers: []CashbackTier{{Money{}, Proportion{}}, {gbp("50.00"), Proportion{}}}}
+	var cases = []struct {
+		cb                               Cashback
+		spend, spentBefore, earnedBefore Money
+	}{
+		{cb, eur, gbp("0.00"), gbp("0.00")},
+		{cb, gbp("1.00"), eur, gbp("0.00")},
+		{cb, gbp("-1.00"), gbp("0.00"), gbp("0.00")},
+		{cb, gbp("1.00"), gbp("-1.00"), gbp("0.00")},
+		{bad, gbp("1.00"), gbp("0.00"), gbp("0.00")},
+		{unbounded, gbp("1.00"), gbp("0.00"), gbp("0.00")},
+	}
+	for i, c := range cases {
+		if got, err := c.cb.Reward(c.spend, c.spentBefore, c.earnedBefore); err == nil {
+			t.Errorf("%d: error expected from Reward, none received, got %+v", i, got)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Package cbor provides CBOR encoding of Money for github.com/fxamacker/cbor.
+//
+// Money is encoded as an array of [currency code, exponent, minor units],
+// wrapped in tag Tag, e.g. 6582133(["GBP", 2, 12345]) for £123.45.
+package cbor
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/itsoneiota/dough-go"
+)
+
+// Tag is the CBOR tag number identifying an encoded Money.
+// It is not registered with IANA; its value spells "dou" in ASCII.
+const Tag uint64 = 0x646f75
+
+// Money is a dough.Money that implements cbor.Marshaler and cbor.Unmarshaler.
+type Money struct {
+	dough.Money
+}
+
+type wire struct {
+	_        struct{} `cbor:",toarray"`
+	Currency string
+	Exponent int
+	Units    int64
+}
+
+// MarshalCBOR implements cbor.Marshaler.
+func (m Money) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(cbor.Tag{
+		Number: Tag,
+		Content: wire{
+			Currency: m.Currency(),
+			Exponent: m.Exponent(),
+			Units:    m.MinorUnits(),
+		},
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler.
+func (m *Money) UnmarshalCBOR(b []byte) error {
+	var t cbor.RawTag
+	if err := cbor.Unmarshal(b, &t); err != nil {
+		return fmt.Errorf("couldn't decode Money: %v", err)
+	}
+	if t.Number != Tag {
+		return fmt.Errorf("couldn't decode Money: expected tag %d, got %d", Tag, t.Number)
+	}
+	var w wire
+	if err := cbor.Unmarshal(t.Content, &w); err != nil {
+		return fmt.Errorf("couldn't decode Money: %v", err)
+	}
+	if w.Exponent < 0 {
+		return fmt.Errorf("couldn't decode Money: invalid exponent %d", w.Exponent)
+	}
+	x, err := dough.FromScaled(w.Currency, w.Units, w.Exponent)
+	if err != nil {
+		return err
+	}
+	m.Money = x
+	return nil
+}
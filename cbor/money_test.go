@@ -0,0 +1,71 @@
+package cbor
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanRoundTripCBOR(t *testing.T) {
+	var cases = []struct {
+		cur string
+		amt string
+	}{
+		{"GBP", "123.45"},
+		{"EUR", "-0.01"},
+		{"AUD", "0.00"},
+		{"USD", "92233720368547758.07"},
+	}
+	for _, c := range cases {
+		x, _ := dough.New(c.cur, c.amt)
+		b, err := cbor.Marshal(Money{x})
+		if err != nil {
+			t.Errorf("error received from Marshal(%v), none expected %v", x, err)
+		}
+		var got Money
+		if err := cbor.Unmarshal(b, &got); err != nil {
+			t.Errorf("error received from Unmarshal(%v), none expected %v", x, err)
+		}
+		if got.Money != x {
+			t.Errorf("wanted %v, got %v", x, got.Money)
+		}
+	}
+}
+
+func TestCanEncodeCBOR(t *testing.T) {
+	x, _ := dough.New("GBP", "-1.00")
+	b, _ := cbor.Marshal(Money{x})
+	// tag(0x646f75), array(3), text(3) "GBP", unsigned(2), negative(99).
+	want := []byte{0xda, 0x00, 0x64, 0x6f, 0x75, 0x83, 0x63, 'G', 'B', 'P', 0x02, 0x38, 0x63}
+	if !bytes.Equal(b, want) {
+		t.Errorf("wanted % x, got % x", want, b)
+	}
+}
+
+func TestCanRejectBadCBOR(t *testing.T) {
+	var cases = []struct {
+		v interface{}
+	}{
+		{[]interface{}{"GBP", 2, 100}},
+		{cbor.Tag{Number: 1, Content: []interface{}{"GBP", 2, 100}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", 2}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"FOO", 2, 100}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", -1, 100}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", 3, 1001}}},
+		{cbor.Tag{Number: Tag, Content: "GBP 1.00"}},
+		// Huge exponents must be rejected, not scaled down one digit at a time.
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", 1 << 40, 1}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", 1 << 40, 0}}},
+		{cbor.Tag{Number: Tag, Content: []interface{}{"GBP", int64(math.MaxInt64), 100}}},
+	}
+	for _, c := range cases {
+		b, _ := cbor.Marshal(c.v)
+		var m Money
+		if err := cbor.Unmarshal(b, &m); err == nil {
+			t.Errorf("error expected from Unmarshal(%v), none received", c.v)
+		}
+	}
+}
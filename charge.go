@@ -0,0 +1,83 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+)
+
+// AllocateCharge distributes a basket-level charge, such as shipping or handling, across lines
+// in proportion to their values, e.g. to give each line a tax base, or to refund the shipping on
+// part of an order. The portions always sum exactly to charge; spare minor units are given to
+// lines from first to last. If every line is zero, the charge is split equally.
+// It returns an error if there are no lines, the amounts aren't all in the same currency,
+// or any line is negative.
+func AllocateCharge(charge Money, lines []Money) ([]Money, error) {
+	weights := make([]uint, len(lines))
+	for i, y := range lines {
+		if y.Currency() != charge.Currency() {
+			return nil, fmt.Errorf("Can't allocate %s charge to %s line %d", charge.Currency(), y.Currency(), i)
+		}
+		if y.a < 0 {
+			return nil, fmt.Errorf("can't allocate charge to negative line %d (%v)", i, y)
+		}
+		weights[i] = uint(y.a)
+	}
+	return AllocateChargeByWeight(charge, weights)
+}
+
+// AllocateChargeByWeight distributes a basket-level charge across lines in proportion to
+// weights, e.g. the lines' shipping weights in grams. The portions always sum exactly to charge;
+// spare minor units are given to lines from first to last. If every weight is zero, the charge
+// is split equally.
+// It returns an error if there are no weights, or a weight is greater than math.MaxInt64.
+func AllocateChargeByWeight(charge Money, weights []uint) ([]Money, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("can't allocate %v to no lines", charge)
+	}
+	ws := make([]int64, len(weights))
+	var any bool
+	for i, w := range weights {
+		if uint64(w) > math.MaxInt64 {
+			return nil, fmt.Errorf("weight %d too large: %d", i, w)
+		}
+		ws[i] = int64(w)
+		any = any || w != 0
+	}
+	if !any {
+		for i := range ws {
+			ws[i] = 1
+		}
+	}
+	res := make([]Money, len(ws))
+	for i, a := range allocate(charge.a, ws) {
+		res[i] = Money{charge.c, a}
+	}
+	return res, nil
+}
+
+// ShareByQuantity allocates x, a pooled amount such as a supplier rebate, across items in
+// proportion to the quantities of each sold. The portions always sum exactly to x; spare minor
+// units are given to items from first to last.
+// It returns an error if there are no quantities, any is negative, or all are zero.
+func (x Money) ShareByQuantity(qtys []int) ([]Money, error) {
+	if len(qtys) == 0 {
+		return nil, fmt.Errorf("can't share %v by no quantities", x)
+	}
+	ws := make([]int64, len(qtys))
+	var any bool
+	for i, q := range qtys {
+		if q < 0 {
+			return nil, fmt.Errorf("can't share by negative quantity %d (%d)", i, q)
+		}
+		ws[i] = int64(q)
+		any = any || q != 0
+	}
+	if !any {
+		return nil, fmt.Errorf("can't share %v when no units were sold", x)
+	}
+	res := make([]Money, len(ws))
+	for i, a := range allocate(x.a, ws) {
+		res[i] = Money{x.c, a}
+	}
+	return res, nil
+}
@@ -0,0 +1,91 @@
+package dough
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanAllocateCharge(t *testing.T) {
+	var cases = []struct {
+		charge Money
+		lines  []Money
+		want   []Money
+	}{
+		{gbp("4.99"), gbps("10.00", "20.00", "70.00"), gbps("0.50", "1.00", "3.49")},
+		{gbp("1.00"), gbps("1.00", "1.00", "1.00"), gbps("0.34", "0.33", "0.33")},
+		{gbp("1.00"), gbps("0.00", "1.00", "1.00"), gbps("0.00", "0.50", "0.50")},
+		{gbp("1.00"), gbps("0.00", "0.00"), gbps("0.50", "0.50")},
+		{gbp("-1.00"), gbps("1.00", "2.00"), gbps("-0.34", "-0.66")},
+		{gbp("0.00"), gbps("1.00", "2.00"), gbps("0.00", "0.00")},
+	}
+	for _, c := range cases {
+		got, err := AllocateCharge(c.charge, c.lines)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("AllocateCharge(%v, %v): wanted %v, got %v (%v)", c.charge, c.lines, c.want, got, err)
+		}
+	}
+}
+
+func TestCanAllocateChargeByWeight(t *testing.T) {
+	var cases = []struct {
+		charge  Money
+		weights []uint
+		want    []Money
+	}{
+		{gbp("10.00"), []uint{500, 1500}, gbps("2.50", "7.50")},
+		{gbp("10.00"), []uint{1, 1, 1}, gbps("3.34", "3.33", "3.33")},
+		{gbp("10.00"), []uint{0, 0}, gbps("5.00", "5.00")},
+		{gbp("0.01"), []uint{0, 1}, gbps("0.00", "0.01")},
+	}
+	for _, c := range cases {
+		got, err := AllocateChargeByWeight(c.charge, c.weights)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("AllocateChargeByWeight(%v, %v): wanted %v, got %v (%v)", c.charge, c.weights, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadChargeAllocation(t *testing.T) {
+	eur, _ := New("EUR", "1.00")
+	var cases = []struct {
+		charge Money
+		lines  []Money
+	}{
+		{gbp("1.00"), nil},
+		{gbp("1.00"), []Money{gbp("1.00"), eur}},
+		{gbp("1.00"), gbps("1.00", "-1.00")},
+	}
+	for _, c := range cases {
+		if got, err := AllocateCharge(c.charge, c.lines); err == nil {
+			t.Errorf("error expected from AllocateCharge(%v, %v), none received, got %v", c.charge, c.lines, got)
+		}
+	}
+	if got, err := AllocateChargeByWeight(gbp("1.00"), nil); err == nil {
+		t.Errorf("error expected from AllocateChargeByWeight with no weights, none received, got %v", got)
+	}
+}
+
+func TestCanShareByQuantity(t *testing.T) {
+	var cases = []struct {
+		x    Money
+		qtys []int
+		want []Money
+	}{
+		{gbp("100.00"), []int{1, 3}, gbps("25.00", "75.00")},
+		{gbp("10.00"), []int{1, 1, 1}, gbps("3.34", "3.33", "3.33")},
+		{gbp("10.00"), []int{0, 2, 1}, gbps("0.00", "6.67", "3.33")},
+		{gbp("-0.05"), []int{2, 2}, gbps("-0.03", "-0.02")},
+		{gbp("1.00"), []int{7}, gbps("1.00")},
+	}
+	for _, c := range cases {
+		got, err := c.x.ShareByQuantity(c.qtys)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v.ShareByQuantity(%v): wanted %v, got %v (%v)", c.x, c.qtys, c.want, got, err)
+		}
+	}
+	for _, qtys := range [][]int{nil, {1, -1}, {0, 0}} {
+		if got, err := gbp("1.00").ShareByQuantity(qtys); err == nil {
+			t.Errorf("error expected from ShareByQuantity(%v), none received, got %v", qtys, got)
+		}
+	}
+}
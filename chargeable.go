@@ -0,0 +1,62 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBelowMinimumCharge is returned, wrapped, by ValidateChargeable when an amount is too small to charge.
+var ErrBelowMinimumCharge = errors.New("amount below minimum charge")
+
+var minimumCharges = struct {
+	sync.RWMutex
+	m map[string]Money
+}{
+	// Defaults are the minimums Stripe publishes for card payments.
+	m: map[string]Money{
+		"AUD": MustNew("AUD", "0.50"),
+		"CAD": MustNew("CAD", "0.50"),
+		"CHF": MustNew("CHF", "0.50"),
+		"DKK": MustNew("DKK", "2.50"),
+		"EUR": MustNew("EUR", "0.50"),
+		"GBP": MustNew("GBP", "0.30"),
+		"HKD": MustNew("HKD", "4.00"),
+		"JPY": MustNew("JPY", "50"),
+		"MXN": MustNew("MXN", "10.00"),
+		"NOK": MustNew("NOK", "3.00"),
+		"NZD": MustNew("NZD", "0.50"),
+		"SEK": MustNew("SEK", "3.00"),
+		"SGD": MustNew("SGD", "0.50"),
+		"USD": MustNew("USD", "0.50"),
+	},
+}
+
+// RegisterMinimumCharge sets the minimum chargeable amount for m's currency, replacing any existing minimum.
+func RegisterMinimumCharge(m Money) {
+	minimumCharges.Lock()
+	defer minimumCharges.Unlock()
+	minimumCharges.m[m.Currency()] = m
+}
+
+// MinimumCharge returns the registered minimum chargeable amount for the given currency.
+// ok is false if no minimum is registered.
+func MinimumCharge(cur string) (m Money, ok bool) {
+	minimumCharges.RLock()
+	defer minimumCharges.RUnlock()
+	m, ok = minimumCharges.m[cur]
+	return m, ok
+}
+
+// ValidateChargeable returns an error if x can't be charged: if it isn't positive,
+// or is less than the minimum registered for its currency.
+// Errors for amounts below the minimum wrap ErrBelowMinimumCharge.
+func ValidateChargeable(x Money) error {
+	if x.a <= 0 {
+		return fmt.Errorf("chargeable amount must be positive, got %v", x)
+	}
+	if min, ok := MinimumCharge(x.Currency()); ok && x.a < min.a {
+		return fmt.Errorf("%w: %v is less than %s", ErrBelowMinimumCharge, x, min.Amount())
+	}
+	return nil
+}
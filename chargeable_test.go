@@ -0,0 +1,53 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanValidateChargeable(t *testing.T) {
+	var cases = []struct {
+		m      Money
+		ok     bool
+		tooLow bool
+	}{
+		{MustNew("USD", "0.50"), true, false},
+		{MustNew("USD", "0.49"), false, true},
+		{MustNew("GBP", "0.30"), true, false},
+		{MustNew("GBP", "0.29"), false, true},
+		{MustNew("JPY", "49"), false, true},
+		{MustNew("JPY", "50"), true, false},
+		{MustNew("ZAR", "0.01"), true, false},
+		{MustNew("ZAR", "0.00"), false, false},
+		{MustNew("USD", "-1.00"), false, false},
+	}
+	for _, c := range cases {
+		err := ValidateChargeable(c.m)
+		if (err == nil) != c.ok {
+			t.Errorf("ValidateChargeable(%v): wanted ok %v, got %v", c.m, c.ok, err)
+		}
+		if errors.Is(err, ErrBelowMinimumCharge) != c.tooLow {
+			t.Errorf("ValidateChargeable(%v): wanted ErrBelowMinimumCharge %v, got %v", c.m, c.tooLow, err)
+		}
+	}
+}
+
+func TestCanRegisterMinimumCharge(t *testing.T) {
+	old, had := MinimumCharge("ZAR")
+	defer func() {
+		minimumCharges.Lock()
+		if had {
+			minimumCharges.m["ZAR"] = old
+		} else {
+			delete(minimumCharges.m, "ZAR")
+		}
+		minimumCharges.Unlock()
+	}()
+	RegisterMinimumCharge(MustNew("ZAR", "5.00"))
+	if got, ok := MinimumCharge("ZAR"); !ok || got.Amount() != "5.00" {
+		t.Errorf("MinimumCharge(ZAR): wanted 5.00, got %v (%v)", got, ok)
+	}
+	if err := ValidateChargeable(MustNew("ZAR", "4.99")); !errors.Is(err, ErrBelowMinimumCharge) {
+		t.Errorf("ValidateChargeable(ZAR 4.99): wanted ErrBelowMinimumCharge, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCanSumColumn(t *testing.T) {
+	var cases = []struct {
+		units []int64
+		want  string
+	}{
+		{nil, "GBP 0.00"},
+		{[]int64{100, 250, -25}, "GBP 3.25"},
+		{[]int64{math.MaxInt64, -1, 1}, "GBP 92233720368547758.07"},
+	}
+	for _, c := range cases {
+		col, err := NewMoneyColumn("GBP", c.units)
+		if err != nil {
+			t.Fatalf("error received from NewMoneyColumn(%v), none expected %v", c.units, err)
+		}
+		if got, err := col.Sum(); err != nil || got.String() != c.want {
+			t.Errorf("Sum(%v): wanted %s, got %v (%v)", c.units, c.want, got, err)
+		}
+	}
+	for _, units := range [][]int64{{math.MaxInt64, 1}, {-math.MaxInt64, -1}} {
+		col, _ := NewMoneyColumn("GBP", units)
+		if got, err := col.Sum(); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Sum(%v): wanted ErrOutOfRange, got %v (%v)", units, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadColumn(t *testing.T) {
+	if _, err := NewMoneyColumn("FOO", []int64{1}); err == nil {
+		t.Errorf("error expected from NewMoneyColumn with bad currency, none received")
+	}
+	if _, err := NewMoneyColumn("GBP", []int64{1, math.MinInt64}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("wanted ErrOutOfRange from NewMoneyColumn with MinInt64, got %v", err)
+	}
+}
+
+func TestCanScaleAndFilterColumn(t *testing.T) {
+	col, _ := NewMoneyColumn("JPY", []int64{100, -5, 0, 30})
+	got, err := col.Scale(3)
+	if err != nil || got.Currency() != "JPY" || !reflect.DeepEqual(got.MinorUnits(), []int64{300, -15, 0, 90}) {
+		t.Errorf("Scale(3): wanted [300 -15 0 90], got %v (%v)", got.MinorUnits(), err)
+	}
+	if col.At(0).String() != "JPY 100" {
+		t.Errorf("Scale modified the original column: %v", col.MinorUnits())
+	}
+	large, _ := NewMoneyColumn("GBP", []int64{1, math.MaxInt64 / 2})
+	if _, err := large.Scale(3); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("wanted ErrOutOfRange from Scale, got %v", err)
+	}
+	pos := col.Filter(func(x Money) bool { return x.MinorUnits() > 0 })
+	if pos.Len() != 2 || pos.At(1).String() != "JPY 30" {
+		t.Errorf("Filter: wanted [100 30], got %v", pos.MinorUnits())
+	}
+}
+
+func TestCanShareColumn(t *testing.T) {
+	var cases = []struct {
+		units      []int64
+		weightings []uint
+	}{
+		{[]int64{100, 5, -5, 0, 105, -30000}, []uint{1, 1, 1}},
+		{[]int64{100, 5, -5, 105, 30000}, []uint{3, 0, 7}},
+		{[]int64{100, -7}, []uint{0, 0}},
+		{[]int64{math.MaxInt64, -math.MaxInt64}, []uint{65535, 1, 2}},
+		{[]int64{62, 1000001}, []uint{9, 73, 11}},
+		{[]int64{12345, -6789}, []uint{math.MaxUint, math.MaxUint, 1}},
+	}
+	for _, c := range cases {
+		col, _ := NewMoneyColumn("GBP", c.units)
+		weightings := append([]uint(nil), c.weightings...)
+		got := col.Share(weightings)
+		if !reflect.DeepEqual(weightings, c.weightings) {
+			t.Errorf("Share modified weightings %v to %v", c.weightings, weightings)
+		}
+		if len(got) != len(c.weightings) {
+			t.Fatalf("Share(%v): wanted %d columns, got %d", c.weightings, len(c.weightings), len(got))
+		}
+		for i, u := range c.units {
+			want := Money{col.c, int(u)}.Share(append([]uint(nil), c.weightings...))
+			for p := range want {
+				if got[p].At(i) != want[p] {
+					t.Errorf("Share(%v) of %d, party %d: wanted %v, got %v", c.weightings, u, p, want[p], got[p].At(i))
+				}
+			}
+		}
+	}
+	if got := (MoneyColumn{}).Share(nil); len(got) != 0 {
+		t.Errorf("wanted no columns from Share with no weightings, got %v", got)
+	}
+}
+
+func TestCanConvertColumn(t *testing.T) {
+	xs := gbps("1.00", "-2.50", "0.00", "92233720368547758.07")
+	col, err := ColumnOf(xs)
+	if err != nil || col.Currency() != "GBP" || !reflect.DeepEqual(col.MinorUnits(), []int64{100, -250, 0, math.MaxInt64}) {
+		t.Errorf("ColumnOf(%v): got %v (%v)", xs, col.MinorUnits(), err)
+	}
+	if got := col.Slice(); !reflect.DeepEqual(got, xs) {
+		t.Errorf("Slice: wanted %v, got %v", xs, got)
+	}
+	if col, err := ColumnOf(nil); err != nil || col.Len() != 0 || len(col.Slice()) != 0 {
+		t.Errorf("ColumnOf(nil): wanted empty column, got %v (%v)", col, err)
+	}
+	if _, err := ColumnOf([]Money{MustNew("GBP", "1.00"), MustNew("EUR", "1.00")}); err == nil {
+		t.Errorf("error expected from ColumnOf with different currencies, none received")
+	}
+}
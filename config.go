@@ -0,0 +1,52 @@
+package dough
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// defaultRounding holds the mode that Default resolves to.
+var defaultRounding atomic.Int32
+
+// DefaultRounding returns the current default rounding mode, which is HalfUp unless changed by SetDefaultRounding.
+// The default applies wherever Default is passed as a RoundingMode, e.g. to MulDecimal, Percent and
+// Convert; modes given explicitly are never affected by it.
+//
+// HalfUp rounds every half away from zero, so when many results are rounded and then added up,
+// as in settlement totals, the total drifts away from zero by half a minor unit for each result
+// that was exactly half way. HalfEven, also known as banker's rounding, rounds halves up and down
+// equally often, so the errors cancel out. To use it as the default:
+//
+//	dough.SetDefaultRounding(dough.HalfEven)
+func DefaultRounding() RoundingMode {
+	return RoundingMode(defaultRounding.Load())
+}
+
+// SetDefaultRounding sets the default rounding mode, which Default resolves to, e.g. to an
+// application's house rounding policy. It is intended to be called once, during initialisation.
+// It returns an error if mode isn't a valid rounding mode.
+func SetDefaultRounding(mode RoundingMode) error {
+	if mode < 0 || int(mode) >= len(roundingModeNames) {
+		return fmt.Errorf("invalid default rounding mode %v", mode)
+	}
+	defaultRounding.Store(int32(mode))
+	return nil
+}
+
+type roundingKey struct{}
+
+// WithRounding returns a copy of ctx carrying mode, for retrieval by RoundingFrom,
+// so that a rounding policy can be set per request or per job.
+func WithRounding(ctx context.Context, mode RoundingMode) context.Context {
+	return context.WithValue(ctx, roundingKey{}, mode)
+}
+
+// RoundingFrom returns the rounding mode carried by ctx, or Default if it carries none.
+// The result can be passed directly to any function that takes a RoundingMode.
+func RoundingFrom(ctx context.Context) RoundingMode {
+	if mode, ok := ctx.Value(roundingKey{}).(RoundingMode); ok {
+		return mode
+	}
+	return Default
+}
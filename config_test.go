@@ -0,0 +1,49 @@
+package dough
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+func TestCanSetDefaultRounding(t *testing.T) {
+	defer SetDefaultRounding(HalfUp)
+	half := big.NewRat(1, 200)
+	if got := DefaultRounding(); got != HalfUp {
+		t.Errorf("wanted initial default HalfUp, got %v", got)
+	}
+	if got, _ := FromRat("GBP", half, Default); got.Amount() != "0.01" {
+		t.Errorf("rounding 0.005 with HalfUp default: wanted 0.01, got %s", got.Amount())
+	}
+	if err := SetDefaultRounding(HalfEven); err != nil {
+		t.Fatalf("error received from SetDefaultRounding, none expected %v", err)
+	}
+	if got, _ := FromRat("GBP", half, Default); got.Amount() != "0.00" {
+		t.Errorf("rounding 0.005 with HalfEven default: wanted 0.00, got %s", got.Amount())
+	}
+	if got, _ := FromRat("GBP", half, Up); got.Amount() != "0.01" {
+		t.Errorf("rounding 0.005 with explicit Up: wanted 0.01, got %s", got.Amount())
+	}
+	for _, m := range []RoundingMode{Default, RoundingMode(-2), RoundingMode(7)} {
+		if err := SetDefaultRounding(m); err == nil {
+			t.Errorf("error expected from SetDefaultRounding(%v), none received", m)
+		}
+	}
+	if Default.String() != "Default" {
+		t.Errorf("wanted Default, got %s", Default)
+	}
+}
+
+func TestCanCarryRoundingInContext(t *testing.T) {
+	ctx := context.Background()
+	if got := RoundingFrom(ctx); got != Default {
+		t.Errorf("wanted Default from empty context, got %v", got)
+	}
+	ctx = WithRounding(ctx, Floor)
+	if got := RoundingFrom(ctx); got != Floor {
+		t.Errorf("wanted Floor from context, got %v", got)
+	}
+	if got, _ := FromRat("GBP", big.NewRat(-1, 1000), RoundingFrom(ctx)); got.Amount() != "-0.01" {
+		t.Errorf("rounding -0.001 with context Floor: wanted -0.01, got %s", got.Amount())
+	}
+}
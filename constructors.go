@@ -0,0 +1,191 @@
+// Code generated by gen_constructors.go; DO NOT EDIT.
+
+package dough
+
+import "golang.org/x/text/currency"
+
+// AUD returns a new AUD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func AUD(amt string) (Money, error) {
+	return newMoney(currency.AUD, amt)
+}
+
+// BRL returns a new BRL Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func BRL(amt string) (Money, error) {
+	return newMoney(currency.BRL, amt)
+}
+
+// CAD returns a new CAD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func CAD(amt string) (Money, error) {
+	return newMoney(currency.CAD, amt)
+}
+
+// CHF returns a new CHF Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func CHF(amt string) (Money, error) {
+	return newMoney(currency.CHF, amt)
+}
+
+// CNY returns a new CNY Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func CNY(amt string) (Money, error) {
+	return newMoney(currency.CNY, amt)
+}
+
+// DKK returns a new DKK Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func DKK(amt string) (Money, error) {
+	return newMoney(currency.DKK, amt)
+}
+
+// EUR returns a new EUR Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func EUR(amt string) (Money, error) {
+	return newMoney(currency.EUR, amt)
+}
+
+// GBP returns a new GBP Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func GBP(amt string) (Money, error) {
+	return newMoney(currency.GBP, amt)
+}
+
+// HKD returns a new HKD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func HKD(amt string) (Money, error) {
+	return newMoney(currency.HKD, amt)
+}
+
+// IDR returns a new IDR Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func IDR(amt string) (Money, error) {
+	return newMoney(currency.IDR, amt)
+}
+
+// INR returns a new INR Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func INR(amt string) (Money, error) {
+	return newMoney(currency.INR, amt)
+}
+
+// JPY returns a new JPY Money for the given amount, e.g. "12345".
+// It returns an error if amt cannot be parsed.
+func JPY(amt string) (Money, error) {
+	return newMoney(currency.JPY, amt)
+}
+
+// KRW returns a new KRW Money for the given amount, e.g. "12345".
+// It returns an error if amt cannot be parsed.
+func KRW(amt string) (Money, error) {
+	return newMoney(currency.KRW, amt)
+}
+
+// MXN returns a new MXN Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func MXN(amt string) (Money, error) {
+	return newMoney(currency.MXN, amt)
+}
+
+// NOK returns a new NOK Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func NOK(amt string) (Money, error) {
+	return newMoney(currency.NOK, amt)
+}
+
+// NZD returns a new NZD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func NZD(amt string) (Money, error) {
+	return newMoney(currency.NZD, amt)
+}
+
+// PLN returns a new PLN Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func PLN(amt string) (Money, error) {
+	return newMoney(currency.PLN, amt)
+}
+
+// RUB returns a new RUB Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func RUB(amt string) (Money, error) {
+	return newMoney(currency.RUB, amt)
+}
+
+// SAR returns a new SAR Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func SAR(amt string) (Money, error) {
+	return newMoney(currency.SAR, amt)
+}
+
+// SEK returns a new SEK Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func SEK(amt string) (Money, error) {
+	return newMoney(currency.SEK, amt)
+}
+
+// THB returns a new THB Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func THB(amt string) (Money, error) {
+	return newMoney(currency.THB, amt)
+}
+
+// TRY returns a new TRY Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func TRY(amt string) (Money, error) {
+	return newMoney(currency.TRY, amt)
+}
+
+// TWD returns a new TWD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func TWD(amt string) (Money, error) {
+	return newMoney(currency.TWD, amt)
+}
+
+// USD returns a new USD Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func USD(amt string) (Money, error) {
+	return newMoney(currency.USD, amt)
+}
+
+// ZAR returns a new ZAR Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func ZAR(amt string) (Money, error) {
+	return newMoney(currency.ZAR, amt)
+}
+
+// XAG returns a new XAG Money for the given amount, e.g. "1.2345".
+// It returns an error if amt cannot be parsed.
+func XAG(amt string) (Money, error) {
+	return newMoney(currency.XAG, amt)
+}
+
+// XAU returns a new XAU Money for the given amount, e.g. "1.2345".
+// It returns an error if amt cannot be parsed.
+func XAU(amt string) (Money, error) {
+	return newMoney(currency.XAU, amt)
+}
+
+// XPD returns a new XPD Money for the given amount, e.g. "1.2345".
+// It returns an error if amt cannot be parsed.
+func XPD(amt string) (Money, error) {
+	return newMoney(currency.XPD, amt)
+}
+
+// XPT returns a new XPT Money for the given amount, e.g. "1.2345".
+// It returns an error if amt cannot be parsed.
+func XPT(amt string) (Money, error) {
+	return newMoney(currency.XPT, amt)
+}
+
+// XTS returns a new XTS Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func XTS(amt string) (Money, error) {
+	return newMoney(currency.XTS, amt)
+}
+
+// XXX returns a new XXX Money for the given amount, e.g. "123.45".
+// It returns an error if amt cannot be parsed.
+func XXX(amt string) (Money, error) {
+	return newMoney(currency.XXX, amt)
+}
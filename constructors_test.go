@@ -0,0 +1,32 @@
+package dough
+
+import "testing"
+
+func TestCanCreateWithCurrencyConstructor(t *testing.T) {
+	var cases = []struct {
+		f   func(string) (Money, error)
+		cur string
+	}{
+		{AUD, "AUD"},
+		{CHF, "CHF"},
+		{EUR, "EUR"},
+		{GBP, "GBP"},
+		{USD, "USD"},
+		{ZAR, "ZAR"},
+		{XTS, "XTS"},
+		{XXX, "XXX"},
+	}
+	for _, c := range cases {
+		sut, err := c.f("123.45")
+		if err != nil {
+			t.Errorf("error received from %s(\"123.45\"), none expected %v", c.cur, err)
+		}
+		want, _ := New(c.cur, "123.45")
+		if sut != want {
+			t.Errorf("wanted %v, got %v", want, sut)
+		}
+		if _, err := c.f("1.2.3"); err == nil {
+			t.Errorf("error expected from %s(\"1.2.3\"), none received", c.cur)
+		}
+	}
+}
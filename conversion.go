@@ -0,0 +1,87 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ConversionStep is one step of a conversion, as recorded in a ConversionTrace.
+type ConversionStep struct {
+	Rate  Rate
+	Mode  RoundingMode
+	Input Money
+	// Exact is the exact result of applying Rate to Input, in major units of Output's currency.
+	Exact *big.Rat
+	// Output is Exact rounded to the currency's minor unit using Mode.
+	Output Money
+	// Adjustment is Output less Exact: the amount added, or taken away if negative, by rounding.
+	Adjustment *big.Rat
+}
+
+// ConversionTrace records how a converted amount was produced, e.g. for customer support.
+type ConversionTrace struct {
+	Steps []ConversionStep
+}
+
+// String returns one line per step, e.g. "GBP 10.00 × GBP/USD 1.255 = 12.55 → USD 12.55 (HalfUp, +0)".
+func (t ConversionTrace) String() string {
+	var b strings.Builder
+	for i, s := range t.Steps {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		adj := decimalString(s.Adjustment)
+		if s.Adjustment.Sign() >= 0 {
+			adj = "+" + adj
+		}
+		fmt.Fprintf(&b, "%v × %v = %s → %v (%v, %s)", s.Input, s.Rate, decimalString(s.Exact), s.Output, s.Mode, adj)
+	}
+	return b.String()
+}
+
+// FeeRate returns a Rate from cur to itself that deducts fee, e.g. 0.98 for a 2% fee, for use as
+// a step of ConvertVia.
+// It returns an error if cur is not well formed or not recognised, or fee isn't less than 100%.
+func FeeRate(cur string, fee Proportion) (Rate, error) {
+	r := new(big.Rat).Sub(big.NewRat(1, 1), fee.Rat())
+	return NewRate(cur, cur, r)
+}
+
+// ConvertVia converts x through each of rates in turn, rounding to the minor unit of each
+// intermediate currency using mode, as happens when a conversion is settled through a bridge
+// currency or fees are deducted along the way. Each rate must start from the previous rate's quote
+// currency. If trace isn't nil, each step is appended to it, with the mode actually applied: Default is
+// recorded as the mode it resolved to.
+// It returns an error if there are no rates, x isn't in the first rate's base currency, the rates
+// don't chain, or a result can't be represented.
+func ConvertVia(x Money, rates []Rate, mode RoundingMode, trace *ConversionTrace) (Money, error) {
+	if len(rates) == 0 {
+		return Money{}, fmt.Errorf("can't convert %v with no rates", x)
+	}
+	if mode == Default {
+		mode = DefaultRounding()
+	}
+	for _, r := range rates {
+		if x.c != r.from {
+			return Money{}, fmt.Errorf("Can't apply %s/%s rate to %s", r.From(), r.To(), x.Currency())
+		}
+		exact := new(big.Rat).Mul(x.Rat(), r.r)
+		y, err := fromRat(r.to, exact, mode)
+		if err != nil {
+			return Money{}, err
+		}
+		if trace != nil {
+			trace.Steps = append(trace.Steps, ConversionStep{
+				Rate:       r,
+				Mode:       mode,
+				Input:      x,
+				Exact:      exact,
+				Output:     y,
+				Adjustment: new(big.Rat).Sub(y.Rat(), exact),
+			})
+		}
+		x = y
+	}
+	return x, nil
+}
@@ -0,0 +1,90 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanConvertVia(t *testing.T) {
+	gbpusd, _ := ParseRate("GBP", "USD", "1.255")
+	usdjpy, _ := ParseRate("USD", "JPY", "150.3")
+	fee, _ := NewPercent(2)
+	usdFee, _ := FeeRate("USD", fee)
+	var trace ConversionTrace
+	got, err := ConvertVia(MustNew("GBP", "10.01"), []Rate{gbpusd, usdFee, usdjpy}, HalfUp, &trace)
+	if err != nil || got.String() != "JPY 1850" {
+		t.Fatalf("wanted JPY 1850, got %v (%v)", got, err)
+	}
+	var steps = []struct {
+		output     string
+		adjustment string
+	}{
+		{"USD 12.56", "-51/20000"}, // 12.56255
+		{"USD 12.31", "3/2500"},    // 12.3088
+		{"JPY 1850", "-193/1000"},  // 1850.193
+	}
+	if len(trace.Steps) != len(steps) {
+		t.Fatalf("wanted %d steps, got %d", len(steps), len(trace.Steps))
+	}
+	for i, s := range steps {
+		st := trace.Steps[i]
+		if st.Output.String() != s.output || st.Adjustment.RatString() != s.adjustment || st.Mode != HalfUp {
+			t.Errorf("step %d: wanted %s (%s), got %v (%s)", i, s.output, s.adjustment, st.Output, st.Adjustment.RatString())
+		}
+		if i > 0 && st.Input != trace.Steps[i-1].Output {
+			t.Errorf("step %d: input %v isn't previous output %v", i, st.Input, trace.Steps[i-1].Output)
+		}
+	}
+	want := "GBP 10.01 × GBP/USD 1.255 = 12.56255 → USD 12.56 (HalfUp, -0.00255)\n" +
+		"USD 12.56 × USD/USD 0.98 = 12.3088 → USD 12.31 (HalfUp, +0.0012)\n" +
+		"USD 12.31 × USD/JPY 150.3 = 1850.193 → JPY 1850 (HalfUp, -0.193)"
+	if s := trace.String(); s != want {
+		t.Errorf("wanted trace\n%s\ngot\n%s", want, s)
+	}
+	if got, err := ConvertVia(MustNew("GBP", "1.00"), []Rate{gbpusd}, Down, nil); err != nil || got.String() != "USD 1.25" {
+		t.Errorf("wanted USD 1.25 without trace, got %v (%v)", got, err)
+	}
+}
+
+func TestCanTraceDefaultRoundingAsApplied(t *testing.T) {
+	defer SetDefaultRounding(HalfUp)
+	if err := SetDefaultRounding(HalfEven); err != nil {
+		t.Fatalf("error received from SetDefaultRounding, none expected %v", err)
+	}
+	gbpusd, _ := ParseRate("GBP", "USD", "1.25")
+	var trace ConversionTrace
+	// GBP 0.10 is USD 0.125, which rounds half-even to USD 0.12.
+	got, err := ConvertVia(MustNew("GBP", "0.10"), []Rate{gbpusd}, Default, &trace)
+	if err != nil || got.String() != "USD 0.12" {
+		t.Fatalf("wanted USD 0.12, got %v (%v)", got, err)
+	}
+	if m := trace.Steps[0].Mode; m != HalfEven {
+		t.Errorf("wanted trace to record HalfEven, got %v", m)
+	}
+}
+
+func TestCanRejectBadConvertVia(t *testing.T) {
+	gbpusd, _ := ParseRate("GBP", "USD", "1.25")
+	usdjpy, _ := ParseRate("USD", "JPY", "150")
+	huge, _ := NewRate("USD", "JPY", big.NewRat(1e18, 1))
+	var cases = []struct {
+		x     Money
+		rates []Rate
+	}{
+		{MustNew("GBP", "1.00"), nil},
+		{MustNew("USD", "1.00"), []Rate{gbpusd}},
+		{MustNew("GBP", "1.00"), []Rate{usdjpy, gbpusd}},
+		{MustNew("GBP", "1.00"), []Rate{gbpusd, gbpusd}},
+		{MustNew("GBP", "100.00"), []Rate{gbpusd, huge}},
+	}
+	for i, c := range cases {
+		var trace ConversionTrace
+		if got, err := ConvertVia(c.x, c.rates, HalfUp, &trace); err == nil {
+			t.Errorf("%d: error expected from ConvertVia, none received, got %v", i, got)
+		}
+	}
+	all, _ := NewPercent(100)
+	if _, err := FeeRate("GBP", all); err == nil {
+		t.Errorf("error expected from FeeRate of 100%%, none received")
+	}
+}
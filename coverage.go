@@ -0,0 +1,31 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CoverageOf reports how much of target x covers, e.g. the progress of payments towards a plan's
+// total or of pledges towards a funding goal. percent is x as a percentage of target, and may be
+// over 100 if x exceeds target; shortfall is what remains to reach target, or zero once it is reached.
+// It returns an error if x and target are in different currencies, or if target isn't positive.
+func (x Money) CoverageOf(target Money) (percent float64, shortfall Money, err error) {
+	if x.c != target.c {
+		return 0, Money{}, fmt.Errorf("Can't compare different currencies (%s and %s)", x.Currency(), target.Currency())
+	}
+	if target.a <= 0 {
+		return 0, Money{}, fmt.Errorf("coverage target must be positive, got %v", target)
+	}
+	r := new(big.Rat).SetFrac(big.NewInt(int64(x.a)), big.NewInt(int64(target.a)))
+	percent, _ = r.Mul(r, big.NewRat(100, 1)).Float64()
+	shortfall = Money{x.c, 0}
+	if x.a < target.a {
+		// x.a < target.a ≤ maxAtoms, and x.a ≥ -maxAtoms, so this can only overflow for a negative x.
+		z, ok := addAtoms(target.a, -x.a)
+		if !ok {
+			return 0, Money{}, fmt.Errorf("%w: %v - %v", ErrOutOfRange, target, x)
+		}
+		shortfall.a = z
+	}
+	return percent, shortfall, nil
+}
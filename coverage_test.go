@@ -0,0 +1,48 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanMeasureCoverage(t *testing.T) {
+	var cases = []struct {
+		x, target string
+		percent   float64
+		shortfall string
+	}{
+		{"0.00", "100.00", 0, "100.00"},
+		{"25.00", "100.00", 25, "75.00"},
+		{"100.00", "100.00", 100, "0.00"},
+		{"150.00", "100.00", 150, "0.00"},
+		{"1.00", "3.00", 100.0 / 3, "2.00"},
+		{"-10.00", "100.00", -10, "110.00"},
+	}
+	for _, c := range cases {
+		percent, shortfall, err := gbp(c.x).CoverageOf(gbp(c.target))
+		if err != nil || percent != c.percent || shortfall.Amount() != c.shortfall || shortfall.Currency() != "GBP" {
+			t.Errorf("%s.CoverageOf(%s): wanted %v%% short %s, got %v%% short %v (%v)", c.x, c.target, c.percent, c.shortfall, percent, shortfall, err)
+		}
+	}
+}
+
+func TestCanRejectBadCoverage(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	lo, _ := MinValue("GBP")
+	var cases = []struct {
+		x, target Money
+	}{
+		{gbp("1.00"), MustNew("EUR", "1.00")},
+		{gbp("1.00"), gbp("0.00")},
+		{gbp("1.00"), gbp("-1.00")},
+		{lo, hi},
+	}
+	for _, c := range cases {
+		if _, _, err := c.x.CoverageOf(c.target); err == nil {
+			t.Errorf("error expected from %v.CoverageOf(%v), none received", c.x, c.target)
+		}
+	}
+	if _, _, err := lo.CoverageOf(hi); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("wanted ErrOutOfRange for shortfall that overflows, got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+// Package decimal converts between Money and github.com/shopspring/decimal, without
+// round-tripping through strings.
+package decimal
+
+import (
+	"github.com/itsoneiota/dough-go"
+	"github.com/shopspring/decimal"
+)
+
+// Money is a dough.Money that converts to a decimal.Decimal.
+type Money struct {
+	dough.Money
+}
+
+// Decimal returns the exact amount of m in major units, with the currency's exponent,
+// e.g. 123.45 for £123.45.
+func (m Money) Decimal() decimal.Decimal {
+	return decimal.New(m.MinorUnits(), -int32(m.Exponent()))
+}
+
+// FromDecimal returns the Money in the given currency nearest to d, rounded to the currency's
+// minor unit using mode.
+// It returns an error if cur is not well formed or not recognised, or if the result can't be represented.
+func FromDecimal(cur string, d decimal.Decimal, mode dough.RoundingMode) (dough.Money, error) {
+	return dough.FromRat(cur, d.Rat(), mode)
+}
@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/shopspring/decimal"
+)
+
+func TestCanConvertToDecimal(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "123.45", "123.45"},
+		{"GBP", "-0.01", "-0.01"},
+		{"GBP", "1.20", "1.2"},
+		{"JPY", "500", "500"},
+		{"KWD", "1.234", "1.234"},
+		{"USD", "92233720368547758.07", "92233720368547758.07"},
+	}
+	for _, c := range cases {
+		x, _ := dough.New(c.cur, c.amt)
+		got := Money{x}.Decimal()
+		if got.String() != c.want {
+			t.Errorf("%v.Decimal(): wanted %s, got %s", x, c.want, got)
+		}
+		if got.Exponent() != -int32(x.Exponent()) {
+			t.Errorf("%v.Decimal(): wanted exponent %d, got %d", x, -x.Exponent(), got.Exponent())
+		}
+		back, err := FromDecimal(c.cur, got, dough.HalfUp)
+		if err != nil || back != x {
+			t.Errorf("FromDecimal(%s, %s): wanted %v, got %v (%v)", c.cur, got, x, back, err)
+		}
+	}
+}
+
+func TestCanConvertFromDecimal(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		d    string
+		mode dough.RoundingMode
+		want string
+	}{
+		{"GBP", "1.2", dough.HalfUp, "1.20"},
+		{"GBP", "1.005", dough.HalfUp, "1.01"},
+		{"GBP", "1.005", dough.HalfEven, "1.00"},
+		{"GBP", "-1.005", dough.HalfUp, "-1.01"},
+		{"JPY", "99.9", dough.Down, "99"},
+		{"GBP", "1e3", dough.HalfUp, "1000.00"},
+	}
+	for _, c := range cases {
+		got, err := FromDecimal(c.cur, decimal.RequireFromString(c.d), c.mode)
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("FromDecimal(%s, %s, %v): wanted %s, got %v (%v)", c.cur, c.d, c.mode, c.want, got, err)
+		}
+	}
+	if _, err := FromDecimal("FOO", decimal.NewFromInt(1), dough.HalfUp); err == nil {
+		t.Errorf("error expected from FromDecimal with bad currency, none received")
+	}
+	if _, err := FromDecimal("GBP", decimal.RequireFromString("1e30"), dough.HalfUp); err == nil {
+		t.Errorf("error expected from FromDecimal with out of range amount, none received")
+	}
+}
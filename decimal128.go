@@ -0,0 +1,126 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// Decimal128 is an IEEE 754-2008 decimal128 value in the binary integer decimal (BID) encoding,
+// the form used by databases and message formats with a native decimal128 type, e.g. BSON.
+// Hi holds the sign, exponent and top 49 bits of the coefficient; Lo holds the rest of the coefficient.
+//
+// Every Money converts exactly to a Decimal128, and back again, preserving the currency's
+// number of decimal places, e.g. £1.20 is 120×10^-2, not 12×10^-1.
+type Decimal128 struct {
+	Hi, Lo uint64
+}
+
+const (
+	decimal128Bias     = 6176
+	decimal128CoeffHi  = 1<<49 - 1
+	decimal128SignBit  = 1 << 63
+	decimal128SpecialB = 3 << 61 // combination field bits marking a large coefficient, infinity or NaN
+	decimal128InfNaN   = 0xf << 59
+)
+
+// maxDecimal128Coeff is the largest canonical coefficient, 10^34-1.
+var maxDecimal128Coeff = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(34), nil), big.NewInt(1))
+
+// Decimal128 returns x as a Decimal128, with the currency's exponent.
+func (x Money) Decimal128() Decimal128 {
+	u := uint64(x.a)
+	var sign uint64
+	if x.a < 0 {
+		u = -u
+		sign = decimal128SignBit
+	}
+	return Decimal128{
+		Hi: sign | uint64(decimal128Bias-x.Exponent())<<49,
+		Lo: u,
+	}
+}
+
+// FromDecimal128 returns the Money in the given currency with the value of d.
+// Any exponent is accepted if the value can be represented exactly, e.g. 12×10^-1 as GBP 1.20.
+// It returns an error if cur is not well formed or not recognised, if d is infinite or NaN,
+// if d can't be represented exactly in the currency's minor units, or one wrapping ErrOutOfRange
+// if d is too large.
+func FromDecimal128(cur string, d Decimal128) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	coeff, q, err := d.parts()
+	if err != nil {
+		return Money{}, err
+	}
+	if coeff.Sign() == 0 {
+		return Money{c: c}, nil
+	}
+	// Shift to minor units, bailing out early on exponents that can't possibly fit.
+	q += exponent(c)
+	switch {
+	case q > 19:
+		return Money{}, fmt.Errorf("%w: decimal128 %s", ErrOutOfRange, d)
+	case q < -34:
+		return Money{}, fmt.Errorf("decimal128 %s can't be represented exactly in %s", d, cur)
+	}
+	r := new(big.Rat).SetInt(coeff)
+	p := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(q))), nil))
+	if q < 0 {
+		r.Quo(r, p)
+	} else {
+		r.Mul(r, p)
+	}
+	if !r.IsInt() {
+		return Money{}, fmt.Errorf("decimal128 %s can't be represented exactly in %s", d, cur)
+	}
+	a, err := roundAtoms(r, Down)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{c, a}, nil
+}
+
+// parts returns the signed coefficient and exponent of d.
+// It returns an error if d is infinite or NaN.
+func (d Decimal128) parts() (coeff *big.Int, q int, err error) {
+	if d.Hi&decimal128InfNaN == decimal128InfNaN {
+		return nil, 0, fmt.Errorf("decimal128 is infinite or NaN")
+	}
+	coeff = new(big.Int)
+	if d.Hi&decimal128SpecialB == decimal128SpecialB {
+		// The coefficient would be at least 2^113, which is non-canonical and treated as zero.
+		q = int(d.Hi>>47&0x3fff) - decimal128Bias
+	} else {
+		q = int(d.Hi>>49&0x3fff) - decimal128Bias
+		coeff.SetUint64(d.Hi & decimal128CoeffHi)
+		coeff.Lsh(coeff, 64)
+		coeff.Or(coeff, new(big.Int).SetUint64(d.Lo))
+		if coeff.Cmp(maxDecimal128Coeff) > 0 {
+			coeff.SetInt64(0)
+		}
+	}
+	if d.Hi&decimal128SignBit != 0 {
+		coeff.Neg(coeff)
+	}
+	return coeff, q, nil
+}
+
+// String returns d in scientific notation, e.g. "12345E-2", or "NaN" for infinities and NaNs.
+func (d Decimal128) String() string {
+	coeff, q, err := d.parts()
+	if err != nil {
+		return "NaN"
+	}
+	return fmt.Sprintf("%sE%d", coeff, q)
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
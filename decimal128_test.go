@@ -0,0 +1,86 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanConvertToDecimal128(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want Decimal128
+	}{
+		{"GBP", "1.00", Decimal128{0x303c000000000000, 100}},
+		{"GBP", "-1.23", Decimal128{0xb03c000000000000, 123}},
+		{"GBP", "0.00", Decimal128{0x303c000000000000, 0}},
+		{"JPY", "500", Decimal128{0x3040000000000000, 500}},
+		{"KWD", "1.234", Decimal128{0x303a000000000000, 1234}},
+		{"GBP", "-92233720368547758.07", Decimal128{0xb03c000000000000, 9223372036854775807}},
+	}
+	for _, c := range cases {
+		x, _ := New(c.cur, c.amt)
+		got := x.Decimal128()
+		if got != c.want {
+			t.Errorf("%v.Decimal128(): wanted %#x, got %#x", x, c.want, got)
+		}
+		back, err := FromDecimal128(c.cur, got)
+		if err != nil || back != x {
+			t.Errorf("FromDecimal128(%s, %v): wanted %v, got %v (%v)", c.cur, got, x, back, err)
+		}
+	}
+}
+
+func TestCanConvertFromDecimal128(t *testing.T) {
+	var cases = []struct {
+		d    Decimal128
+		want string
+	}{
+		{Decimal128{0x3040000000000000, 12}, "12.00"},
+		{Decimal128{0x303e000000000000, 12}, "1.20"},
+		{Decimal128{0x3038000000000000, 12300}, "1.23"},
+		{Decimal128{0x3042000000000000, 5}, "50.00"},
+		{Decimal128{0xb040000000000000, 0}, "0.00"},
+		{Decimal128{0x6c10000000000000, 7}, "0.00"},
+	}
+	for _, c := range cases {
+		got, err := FromDecimal128("GBP", c.d)
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("FromDecimal128(GBP, %v): wanted %s, got %v (%v)", c.d, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadDecimal128(t *testing.T) {
+	var cases = []struct {
+		cur      string
+		d        Decimal128
+		outRange bool
+	}{
+		{"FOO", Decimal128{0x303c000000000000, 100}, false},
+		{"GBP", Decimal128{0x303a000000000000, 1001}, false},
+		{"GBP", Decimal128{0x2000000000000000, 1}, false},
+		{"GBP", Decimal128{0x7800000000000000, 0}, false},
+		{"GBP", Decimal128{0x7c00000000000000, 0}, false},
+		{"GBP", Decimal128{0x3068000000000000, 1}, true},
+		{"GBP", Decimal128{0x3040000000000000, 1 << 62}, true},
+	}
+	for _, c := range cases {
+		got, err := FromDecimal128(c.cur, c.d)
+		if err == nil {
+			t.Errorf("error expected from FromDecimal128(%s, %v), none received, got %v", c.cur, c.d, got)
+		}
+		if c.outRange && !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("FromDecimal128(%s, %v): wanted ErrOutOfRange, got %v", c.cur, c.d, err)
+		}
+	}
+}
+
+func TestCanFormatDecimal128(t *testing.T) {
+	if got := MustNew("GBP", "-1.23").Decimal128().String(); got != "-123E-2" {
+		t.Errorf("wanted -123E-2, got %s", got)
+	}
+	if got := (Decimal128{0x7c00000000000000, 0}).String(); got != "NaN" {
+		t.Errorf("wanted NaN, got %s", got)
+	}
+}
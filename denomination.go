@@ -0,0 +1,123 @@
+package dough
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DenominationSet is the notes and coins available in a currency, largest first.
+type DenominationSet struct {
+	values []Money
+}
+
+// NewDenominationSet returns a new DenominationSet of the given notes and coins.
+// It returns an error if there are none, if any is not positive, or if they are in different currencies.
+func NewDenominationSet(values ...Money) (DenominationSet, error) {
+	if len(values) == 0 {
+		return DenominationSet{}, fmt.Errorf("denomination set must not be empty")
+	}
+	vs := make([]Money, 0, len(values))
+	seen := map[Money]bool{}
+	for _, v := range values {
+		if v.Currency() != values[0].Currency() {
+			return DenominationSet{}, fmt.Errorf("denominations must be in the same currency, got %s and %s", values[0].Currency(), v.Currency())
+		}
+		if v.a <= 0 {
+			return DenominationSet{}, fmt.Errorf("denominations must be positive, got %v", v)
+		}
+		if !seen[v] {
+			seen[v] = true
+			vs = append(vs, v)
+		}
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i].a > vs[j].a })
+	return DenominationSet{vs}, nil
+}
+
+// MustNewDenominationSet is like NewDenominationSet, but takes amounts as strings and panics on error.
+// It simplifies the initialisation of sets in variable declarations.
+func MustNewDenominationSet(cur string, amts ...string) DenominationSet {
+	vs := make([]Money, len(amts))
+	for i, a := range amts {
+		m, err := New(cur, a)
+		if err != nil {
+			panic(err)
+		}
+		vs[i] = m
+	}
+	s, err := NewDenominationSet(vs...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Currency gets the currency of the set.
+func (s DenominationSet) Currency() string {
+	if len(s.values) == 0 {
+		return ""
+	}
+	return s.values[0].Currency()
+}
+
+// Values returns the denominations in the set, largest first.
+func (s DenominationSet) Values() []Money {
+	return append([]Money(nil), s.values...)
+}
+
+var denominations = struct {
+	sync.RWMutex
+	m map[string]DenominationSet
+}{
+	m: map[string]DenominationSet{
+		"EUR": MustNewDenominationSet("EUR", "500.00", "200.00", "100.00", "50.00", "20.00", "10.00", "5.00", "2.00", "1.00", "0.50", "0.20", "0.10", "0.05", "0.02", "0.01"),
+		"GBP": MustNewDenominationSet("GBP", "50.00", "20.00", "10.00", "5.00", "2.00", "1.00", "0.50", "0.20", "0.10", "0.05", "0.02", "0.01"),
+		"USD": MustNewDenominationSet("USD", "100.00", "50.00", "20.00", "10.00", "5.00", "2.00", "1.00", "0.25", "0.10", "0.05", "0.01"),
+	},
+}
+
+// RegisterDenominations sets the denominations for the set's currency, replacing any existing set.
+func RegisterDenominations(s DenominationSet) {
+	denominations.Lock()
+	defer denominations.Unlock()
+	denominations.m[s.Currency()] = s
+}
+
+// DenominationsFor returns the registered denominations for the given currency.
+// Sets for EUR, GBP and USD are registered by default.
+func DenominationsFor(cur string) (DenominationSet, bool) {
+	denominations.RLock()
+	defer denominations.RUnlock()
+	s, ok := denominations.m[cur]
+	return s, ok
+}
+
+// Denominations breaks the Money down into notes and coins from set, using as many of
+// each denomination as possible, largest first. The result maps each denomination used to its count.
+// This gives the fewest notes and coins for the usual currency systems, such as GBP, EUR and USD.
+// It returns an error if the Money is negative, in a different currency from the set,
+// or can't be made up exactly from the set.
+func (x Money) Denominations(set DenominationSet) (map[Money]int, error) {
+	if x.a < 0 {
+		return nil, fmt.Errorf("can't break down negative amount %v", x)
+	}
+	if len(set.values) == 0 {
+		return nil, fmt.Errorf("denomination set is empty")
+	}
+	if set.Currency() != x.Currency() {
+		return nil, fmt.Errorf("Can't break down %s into %s denominations", x.Currency(), set.Currency())
+	}
+	res := map[Money]int{}
+	rem := x.a
+	for _, v := range set.values {
+		if n := rem / v.a; n > 0 {
+			res[v] = n
+			rem -= n * v.a
+		}
+	}
+	if rem != 0 {
+		return nil, fmt.Errorf("can't make %v from denominations %v", x, set.values)
+	}
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package dough
+
+import "testing"
+
+func TestCanBreakDownIntoDenominations(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want map[string]int
+	}{
+		{"GBP", "0.00", map[string]int{}},
+		{"GBP", "88.88", map[string]int{"50.00": 1, "20.00": 1, "10.00": 1, "5.00": 1, "2.00": 1, "1.00": 1, "0.50": 1, "0.20": 1, "0.10": 1, "0.05": 1, "0.02": 1, "0.01": 1}},
+		{"GBP", "140.00", map[string]int{"50.00": 2, "20.00": 2}},
+		{"USD", "0.99", map[string]int{"0.25": 3, "0.10": 2, "0.01": 4}},
+		{"EUR", "1234.56", map[string]int{"500.00": 2, "200.00": 1, "20.00": 1, "10.00": 1, "2.00": 2, "0.50": 1, "0.05": 1, "0.01": 1}},
+	}
+	for _, c := range cases {
+		x, _ := New(c.cur, c.amt)
+		set, _ := DenominationsFor(c.cur)
+		got, err := x.Denominations(set)
+		if err != nil {
+			t.Errorf("error received from Denominations(%v), none expected %v", x, err)
+			continue
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%v: wanted %v, got %v", x, c.want, got)
+		}
+		for a, n := range c.want {
+			d, _ := New(c.cur, a)
+			if got[d] != n {
+				t.Errorf("%v: wanted %d × %s, got %d", x, n, a, got[d])
+			}
+		}
+	}
+}
+
+func TestCanRegisterDenominations(t *testing.T) {
+	RegisterDenominations(MustNewDenominationSet("JPY", "10000", "5000", "1000", "500", "100", "50", "10", "5", "1"))
+	set, ok := DenominationsFor("JPY")
+	if !ok || set.Currency() != "JPY" || len(set.Values()) != 9 {
+		t.Fatalf("wanted registered JPY set, got %v", set)
+	}
+	x, _ := New("JPY", "16666")
+	got, _ := x.Denominations(set)
+	if n := got[Money{x.c, 5000}]; n != 1 {
+		t.Errorf("wanted one 5000 note, got %d", n)
+	}
+}
+
+func TestCanRejectBadDenominations(t *testing.T) {
+	gbp, _ := New("GBP", "1.00")
+	eur, _ := New("EUR", "1.00")
+	zero, _ := New("GBP", "0.00")
+	if _, err := NewDenominationSet(); err == nil {
+		t.Errorf("error expected from empty set, none received")
+	}
+	if _, err := NewDenominationSet(gbp, eur); err == nil {
+		t.Errorf("error expected from mixed currency set, none received")
+	}
+	if _, err := NewDenominationSet(gbp, zero); err == nil {
+		t.Errorf("error expected from set with zero, none received")
+	}
+	set := MustNewDenominationSet("GBP", "0.05", "1.00")
+	if set.Values()[0] != gbp {
+		t.Errorf("wanted set sorted largest first, got %v", set.Values())
+	}
+	odd, _ := New("GBP", "1.03")
+	if _, err := odd.Denominations(set); err == nil {
+		t.Errorf("error expected when amount can't be made, none received")
+	}
+	neg, _ := New("GBP", "-1.00")
+	if _, err := neg.Denominations(set); err == nil {
+		t.Errorf("error expected breaking down negative amount, none received")
+	}
+	if _, err := eur.Denominations(set); err == nil {
+		t.Errorf("error expected breaking down EUR into GBP, none received")
+	}
+}
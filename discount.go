@@ -0,0 +1,32 @@
+package dough
+
+// DiscountLine is a basket line to which an order-level discount may be allocated.
+type DiscountLine struct {
+	Amount Money
+	// Excluded excludes the line from the promotion, so it receives none of the discount.
+	Excluded bool
+}
+
+// AllocateDiscount distributes an order-level discount across the lines that aren't excluded,
+// in proportion to their amounts, e.g. for returns and per-line tax. No line is discounted by
+// more than its amount, and spare minor units are given to lines from first to last.
+// It returns each line's discount and its net amount, the line's amount less its discount.
+// The discounts sum exactly to discount, and the nets to the lines' total less discount.
+// It returns an error if the amounts aren't all in the same currency, if any line or the discount
+// is negative, or if the discount is greater than the total of the lines that aren't excluded,
+// or one wrapping ErrOutOfRange if that total would overflow.
+func AllocateDiscount(discount Money, lines []DiscountLine) (discounts, nets []Money, err error) {
+	amounts := make([]Money, len(lines))
+	excluded := make([]bool, len(lines))
+	for i, l := range lines {
+		amounts[i], excluded[i] = l.Amount, l.Excluded
+	}
+	if discounts, err = allocateByAmount("discount", discount, amounts, excluded); err != nil {
+		return nil, nil, err
+	}
+	nets = make([]Money, len(lines))
+	for i, l := range lines {
+		nets[i] = Money{discount.c, l.Amount.a - discounts[i].a}
+	}
+	return discounts, nets, nil
+}
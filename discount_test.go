@@ -0,0 +1,54 @@
+package dough
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCanAllocateDiscount(t *testing.T) {
+	var cases = []struct {
+		discount  Money
+		lines     []DiscountLine
+		discounts []Money
+		nets      []Money
+	}{
+		{gbp("10.00"), []DiscountLine{{gbp("30.00"), false}, {gbp("70.00"), false}}, gbps("3.00", "7.00"), gbps("27.00", "63.00")},
+		{gbp("10.00"), []DiscountLine{{gbp("30.00"), false}, {gbp("50.00"), true}, {gbp("70.00"), false}}, gbps("3.00", "0.00", "7.00"), gbps("27.00", "50.00", "63.00")},
+		{gbp("1.00"), []DiscountLine{{gbp("1.00"), false}, {gbp("1.00"), false}, {gbp("1.00"), false}}, gbps("0.34", "0.33", "0.33"), gbps("0.66", "0.67", "0.67")},
+		{gbp("5.00"), []DiscountLine{{gbp("5.00"), false}, {gbp("9.99"), true}}, gbps("5.00", "0.00"), gbps("0.00", "9.99")},
+		{gbp("0.00"), []DiscountLine{{gbp("5.00"), true}}, gbps("0.00"), gbps("5.00")},
+	}
+	for _, c := range cases {
+		discounts, nets, err := AllocateDiscount(c.discount, c.lines)
+		if err != nil || !reflect.DeepEqual(discounts, c.discounts) || !reflect.DeepEqual(nets, c.nets) {
+			t.Errorf("AllocateDiscount(%v, %v): wanted %v and %v, got %v and %v (%v)", c.discount, c.lines, c.discounts, c.nets, discounts, nets, err)
+		}
+	}
+}
+
+func TestCanRejectBadDiscountAllocation(t *testing.T) {
+	eur, _ := New("EUR", "1.00")
+	var cases = []struct {
+		discount Money
+		lines    []DiscountLine
+	}{
+		{gbp("-1.00"), []DiscountLine{{gbp("1.00"), false}}},
+		{gbp("1.00"), []DiscountLine{{eur, false}}},
+		{gbp("1.00"), []DiscountLine{{gbp("-1.00"), false}, {gbp("5.00"), false}}},
+		{gbp("1.00"), []DiscountLine{{gbp("0.99"), false}, {gbp("5.00"), true}}},
+		{gbp("1.00"), nil},
+	}
+	for _, c := range cases {
+		if d, _, err := AllocateDiscount(c.discount, c.lines); err == nil {
+			t.Errorf("error expected from AllocateDiscount(%v, %v), none received, got %v", c.discount, c.lines, d)
+		}
+	}
+}
+
+func TestCanRejectDiscountOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	if _, _, err := AllocateDiscount(gbp("1.00"), []DiscountLine{{hi, false}, {hi, false}}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AllocateDiscount with lines totalling 2 × %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+}
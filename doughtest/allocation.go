@@ -0,0 +1,109 @@
+package doughtest
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// The invariants below hold for any allocation of a total between parts, such as the results of
+// Share, RefundAllocate or AllocateCharge. Each returns an error describing the first violation,
+// so they can be used outside tests too, e.g. as assertions in development builds.
+
+// SumsToTotal checks that parts are all in total's currency and sum exactly to total,
+// so that no minor units are made or lost.
+func SumsToTotal(total dough.Money, parts []dough.Money) error {
+	sum := new(big.Rat)
+	for i, p := range parts {
+		if p.Currency() != total.Currency() {
+			return fmt.Errorf("part %d is %v, wanted %s", i, p, total.Currency())
+		}
+		sum.Add(sum, p.Rat())
+	}
+	if sum.Cmp(total.Rat()) != 0 {
+		return fmt.Errorf("parts %v sum to %s, wanted %v", parts, sum.FloatString(total.Exponent()), total)
+	}
+	return nil
+}
+
+// WithinTotal checks that no part is larger in magnitude than total.
+func WithinTotal(total dough.Money, parts []dough.Money) error {
+	for i, p := range parts {
+		if abs(p.MinorUnits()) > abs(total.MinorUnits()) {
+			return fmt.Errorf("part %d is %v, which exceeds the total %v", i, p, total)
+		}
+	}
+	return nil
+}
+
+// SignConsistent checks that every part is zero or has the same sign as total,
+// e.g. that splitting a refund never produces a charge.
+func SignConsistent(total dough.Money, parts []dough.Money) error {
+	for i, p := range parts {
+		if p.MinorUnits() != 0 && sign(p.MinorUnits()) != sign(total.MinorUnits()) {
+			return fmt.Errorf("part %d is %v, which has the opposite sign to the total %v", i, p, total)
+		}
+	}
+	return nil
+}
+
+// OrderedByWeight checks that parts are ordered consistently with the weights they were allocated by:
+// a part never has a smaller magnitude than one with a lower weight, beyond the one minor unit of
+// a spare unit, and among parts of equal weight, spare units go to the earlier parts, so an earlier
+// part is never smaller than a later one.
+// It returns an error if parts and weights differ in length.
+func OrderedByWeight(parts []dough.Money, weights []uint) error {
+	if len(parts) != len(weights) {
+		return fmt.Errorf("%d parts for %d weights", len(parts), len(weights))
+	}
+	for i := range parts {
+		for j := range parts {
+			a, b := abs(parts[i].MinorUnits()), abs(parts[j].MinorUnits())
+			switch {
+			case weights[i] > weights[j] && a < b-1:
+				return fmt.Errorf("part %d is %v with weight %d, but part %d is %v with lower weight %d", i, parts[i], weights[i], j, parts[j], weights[j])
+			case weights[i] == weights[j] && i < j && a < b:
+				return fmt.Errorf("part %d is %v, but later part %d of equal weight is %v", i, parts[i], j, parts[j])
+			}
+		}
+	}
+	return nil
+}
+
+// CheckAllocation checks that parts are a valid allocation of total, reporting every invariant
+// violated through t. If weights is nil, the parts aren't checked against them.
+func CheckAllocation(t testing.TB, total dough.Money, parts []dough.Money, weights []uint) {
+	t.Helper()
+	errs := []error{
+		SumsToTotal(total, parts),
+		WithinTotal(total, parts),
+		SignConsistent(total, parts),
+	}
+	if weights != nil {
+		errs = append(errs, OrderedByWeight(parts, weights))
+	}
+	for _, err := range errs {
+		if err != nil {
+			t.Errorf("allocating %v: %v", total, err)
+		}
+	}
+}
+
+func abs(a int64) int64 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func sign(a int64) int {
+	switch {
+	case a < 0:
+		return -1
+	case a > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package doughtest
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func gbps(amts ...string) []dough.Money {
+	res := make([]dough.Money, len(amts))
+	for i, a := range amts {
+		res[i] = dough.MustNew("GBP", a)
+	}
+	return res
+}
+
+func TestCanCheckAllocationInvariants(t *testing.T) {
+	var cases = []struct {
+		total   string
+		parts   []dough.Money
+		weights []uint
+		sums    bool
+		within  bool
+		signs   bool
+		ordered bool
+	}{
+		{"1.00", gbps("0.34", "0.33", "0.33"), []uint{1, 1, 1}, true, true, true, true},
+		{"-1.00", gbps("-0.34", "-0.33", "-0.33"), []uint{1, 1, 1}, true, true, true, true},
+		{"1.00", gbps("0.00", "1.00"), []uint{0, 1}, true, true, true, true},
+		{"0.05", gbps("0.02", "0.03"), []uint{1, 1}, true, true, true, false},
+		{"0.05", gbps("0.03", "0.01"), []uint{1, 1}, false, true, true, true},
+		{"1.00", gbps("1.50", "-0.50"), []uint{3, 1}, true, false, false, true},
+		{"1.00", gbps("0.20", "0.80"), []uint{3, 1}, true, true, true, false},
+		{"0.02", gbps("0.00", "0.01", "0.01"), []uint{2, 1, 1}, true, true, true, true},
+		{"1.00", []dough.Money{dough.MustNew("GBP", "0.50"), dough.MustNew("EUR", "0.50")}, []uint{1, 1}, false, true, true, true},
+		{"1.00", gbps("1.00"), []uint{1, 1}, true, true, true, false},
+	}
+	for _, c := range cases {
+		total := dough.MustNew("GBP", c.total)
+		checks := []struct {
+			name string
+			err  error
+			want bool
+		}{
+			{"SumsToTotal", SumsToTotal(total, c.parts), c.sums},
+			{"WithinTotal", WithinTotal(total, c.parts), c.within},
+			{"SignConsistent", SignConsistent(total, c.parts), c.signs},
+			{"OrderedByWeight", OrderedByWeight(c.parts, c.weights), c.ordered},
+		}
+		for _, ch := range checks {
+			if (ch.err == nil) != ch.want {
+				t.Errorf("%s(%v, %v, %v): wanted pass %t, got %v", ch.name, total, c.parts, c.weights, ch.want, ch.err)
+			}
+		}
+		rec := &recorder{TB: t}
+		CheckAllocation(rec, total, c.parts, c.weights)
+		if want := !(c.sums && c.within && c.signs && c.ordered); rec.failed != want {
+			t.Errorf("CheckAllocation(%v, %v, %v): wanted failure %t, got %t", total, c.parts, c.weights, want, rec.failed)
+		}
+	}
+}
+
+func FuzzAllocateChargeByWeight(f *testing.F) {
+	f.Add(int64(100), uint16(1), uint16(1), uint16(1))
+	f.Add(int64(-2), uint16(2), uint16(1), uint16(1))
+	f.Add(int64(99999), uint16(0), uint16(7), uint16(7))
+	f.Fuzz(func(t *testing.T, charge int64, a, b, c uint16) {
+		x, err := dough.FromMinorUnits("GBP", charge)
+		if err != nil {
+			return
+		}
+		weights := []uint{uint(a), uint(b), uint(c)}
+		parts, err := dough.AllocateChargeByWeight(x, weights)
+		if err != nil {
+			return
+		}
+		CheckAllocation(t, x, parts, weights)
+	})
+}
@@ -0,0 +1,199 @@
+// Package doughtest checks the results of dough operations against a reference implementation
+// using exact big.Rat arithmetic, for use in unit, property and fuzz tests.
+//
+// The Check functions run an operation and report any disagreement with the reference through
+// t, so they can be called from ordinary tests, or from fuzz targets, e.g.
+//
+//	func FuzzAdd(f *testing.F) {
+//		f.Add(int64(1), int64(2))
+//		f.Fuzz(func(t *testing.T, a, b int64) {
+//			x, _ := dough.FromMinorUnits("GBP", a)
+//			y, _ := dough.FromMinorUnits("GBP", b)
+//			doughtest.CheckAdd(t, x, y)
+//		})
+//	}
+//
+// To check a composition of operations, calculate the exact result with big.Rat and compare it
+// with CheckResult.
+package doughtest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// CheckResult checks that got and err are the result expected of an operation whose exact result
+// is want major units of the currency cur: either got is exactly want in cur, or want can't be
+// represented and err wraps dough.ErrOutOfRange. op describes the operation, for failure messages.
+func CheckResult(t testing.TB, op string, got dough.Money, err error, cur string, want *big.Rat) {
+	t.Helper()
+	if !representable(cur, want) {
+		if !errors.Is(err, dough.ErrOutOfRange) {
+			t.Errorf("%s: wanted ErrOutOfRange for %s %s, got %v (%v)", op, cur, want.RatString(), got, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("%s: wanted %s %s, got error %v", op, cur, want.RatString(), err)
+		return
+	}
+	if got.Currency() != cur || got.Rat().Cmp(want) != 0 {
+		t.Errorf("%s: wanted %s %s, got %v", op, cur, want.RatString(), got)
+	}
+}
+
+// representable reports whether r major units can be held by a Money in cur.
+func representable(cur string, r *big.Rat) bool {
+	max, err := dough.MaxValue(cur)
+	if err != nil {
+		return false
+	}
+	return new(big.Rat).Abs(r).Cmp(max.Rat()) <= 0
+}
+
+// CheckAdd checks x.Add(y) against the reference.
+func CheckAdd(t testing.TB, x, y dough.Money) {
+	t.Helper()
+	got, err := x.Add(y)
+	if checkCurrencies(t, "Add", x, y, err) {
+		CheckResult(t, x.String()+" + "+y.String(), got, err, x.Currency(), new(big.Rat).Add(x.Rat(), y.Rat()))
+	}
+}
+
+// CheckSub checks x.Sub(y) against the reference.
+func CheckSub(t testing.TB, x, y dough.Money) {
+	t.Helper()
+	got, err := x.Sub(y)
+	if checkCurrencies(t, "Sub", x, y, err) {
+		CheckResult(t, x.String()+" - "+y.String(), got, err, x.Currency(), new(big.Rat).Sub(x.Rat(), y.Rat()))
+	}
+}
+
+// checkCurrencies checks that an operation on x and y returned an error if their currencies differ,
+// and reports whether they are the same, so that the result should be checked.
+func checkCurrencies(t testing.TB, op string, x, y dough.Money, err error) bool {
+	t.Helper()
+	if x.Currency() == y.Currency() {
+		return true
+	}
+	if err == nil {
+		t.Errorf("%s(%v, %v): wanted error for different currencies, none received", op, x, y)
+	}
+	return false
+}
+
+// CheckMul checks x.Mul(f) against the reference.
+func CheckMul(t testing.TB, x dough.Money, f int) {
+	t.Helper()
+	got, err := x.Mul(f)
+	want := new(big.Rat).Mul(x.Rat(), new(big.Rat).SetInt64(int64(f)))
+	CheckResult(t, x.String()+" × "+big.NewInt(int64(f)).String(), got, err, x.Currency(), want)
+}
+
+// CheckShare checks x.Share(weightings) against the reference: the parts are a valid allocation of x,
+// as checked by CheckAllocation, each part is at most one minor unit from its exact share, and parts
+// with a zero weighting are zero, unless all are, when the shares are equal. weightings is not modified.
+func CheckShare(t testing.TB, x dough.Money, weightings []uint) {
+	t.Helper()
+	w := append([]uint(nil), weightings...)
+	parts := x.Share(w)
+	if len(parts) != len(weightings) {
+		t.Errorf("%v.Share(%v): wanted %d parts, got %d", x, weightings, len(weightings), len(parts))
+		return
+	}
+	sum := new(big.Int)
+	for _, w := range weightings {
+		sum.Add(sum, new(big.Int).SetUint64(uint64(w)))
+	}
+	equal := sum.Sign() == 0
+	if equal {
+		sum.SetInt64(int64(len(weightings)))
+	}
+	CheckAllocation(t, x, parts, weightings)
+	unit := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(x.Exponent())), nil))
+	for i, p := range parts {
+		wi := big.NewInt(1)
+		if !equal {
+			wi.SetUint64(uint64(weightings[i]))
+		}
+		exact := new(big.Rat).Mul(x.Rat(), new(big.Rat).SetFrac(wi, sum))
+		if d := new(big.Rat).Sub(p.Rat(), exact); d.Abs(d).Cmp(unit) > 0 || wi.Sign() == 0 && p.MinorUnits() != 0 {
+			t.Errorf("%v.Share(%v): part %d is %v, wanted at most a minor unit from %s", x, weightings, i, p, exact.FloatString(x.Exponent()+2))
+		}
+	}
+}
+
+// CheckConvert checks dough.Convert(x, to, rates, mode) against the reference, which multiplies
+// x by the rate from rates and rounds the exact product to the minor unit of to using mode.
+func CheckConvert(t testing.TB, x dough.Money, to string, rates dough.RateProvider, mode dough.RoundingMode) {
+	t.Helper()
+	op := "Convert(" + x.String() + ", " + to + ", " + mode.String() + ")"
+	got, err := dough.Convert(x, to, rates, mode)
+	if x.Currency() == to {
+		CheckResult(t, op, got, err, to, x.Rat())
+		return
+	}
+	unit, cerr := dough.FromMinorUnits(to, 1)
+	r, rerr := rates.Rate(x.Currency(), to)
+	if cerr != nil || rerr != nil || r == nil || r.Sign() <= 0 {
+		if err == nil {
+			t.Errorf("%s: wanted error for rate %v (%v, %v), got %v", op, r, cerr, rerr, got)
+		}
+		return
+	}
+	CheckResult(t, op, got, err, to, Round(new(big.Rat).Mul(x.Rat(), r), unit.Exponent(), mode))
+}
+
+// Round returns r rounded to the given number of decimal places using mode. It is the reference
+// for dough's rounding, and is written independently of it: it picks the nearer of the two
+// candidates either side of r, falling back to mode's rule for ties and the directed modes.
+func Round(r *big.Rat, places int, mode dough.RoundingMode) *big.Rat {
+	if mode == dough.Default {
+		mode = dough.DefaultRounding()
+	}
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+	s := new(big.Rat).Mul(r, scale)
+	if s.IsInt() {
+		return new(big.Rat).Set(r)
+	}
+	// lo and hi are the integers either side of s.
+	lo := new(big.Int).Div(s.Num(), s.Denom())
+	hi := new(big.Int).Add(lo, big.NewInt(1))
+	mid := new(big.Rat).SetFrac(new(big.Int).Add(new(big.Int).Add(lo, lo), big.NewInt(1)), big.NewInt(2))
+	pos := s.Sign() > 0
+	var up bool
+	switch mode {
+	case dough.Up:
+		up = pos
+	case dough.Down:
+		up = !pos
+	case dough.Ceiling:
+		up = true
+	case dough.Floor:
+		up = false
+	default:
+		switch s.Cmp(mid) {
+		case -1:
+			up = false
+		case 1:
+			up = true
+		default:
+			switch mode {
+			case dough.HalfUp:
+				up = pos
+			case dough.HalfDown:
+				up = !pos
+			case dough.HalfEven:
+				up = hi.Bit(0) == 0
+			}
+		}
+	}
+	res := lo
+	if up {
+		res = hi
+	}
+	return new(big.Rat).Quo(new(big.Rat).SetInt(res), scale)
+}
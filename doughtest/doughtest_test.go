@@ -0,0 +1,135 @@
+package doughtest
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+var currencies = []string{"GBP", "JPY", "KWD"}
+
+func money(cur int, units int64) dough.Money {
+	x, _ := dough.FromMinorUnits(currencies[int(uint(cur)%uint(len(currencies)))], units)
+	return x
+}
+
+func FuzzAdd(f *testing.F) {
+	f.Add(0, int64(12345), 0, int64(-99))
+	f.Add(0, int64(math.MaxInt64), 0, int64(1))
+	f.Add(1, int64(math.MinInt64+1), 1, int64(-1))
+	f.Add(0, int64(1), 1, int64(1))
+	f.Fuzz(func(t *testing.T, xc int, x int64, yc int, y int64) {
+		CheckAdd(t, money(xc, x), money(yc, y))
+		CheckSub(t, money(xc, x), money(yc, y))
+	})
+}
+
+func FuzzMul(f *testing.F) {
+	f.Add(0, int64(12345), 3)
+	f.Add(2, int64(-7), -1)
+	f.Add(0, int64(math.MaxInt64/2+1), 2)
+	f.Add(1, int64(math.MaxInt64), -1)
+	f.Fuzz(func(t *testing.T, c int, x int64, m int) {
+		CheckMul(t, money(c, x), m)
+	})
+}
+
+func FuzzShare(f *testing.F) {
+	f.Add(0, int64(100), uint16(1), uint16(1), uint16(1))
+	f.Add(0, int64(-100), uint16(3), uint16(0), uint16(7))
+	f.Add(1, int64(5), uint16(0), uint16(0), uint16(0))
+	f.Add(2, int64(math.MaxInt64), uint16(65535), uint16(1), uint16(2))
+	f.Add(0, int64(math.MaxInt64), uint16(1), uint16(0), uint16(0))
+	f.Fuzz(func(t *testing.T, c int, x int64, a, b, d uint16) {
+		CheckShare(t, money(c, x), []uint{uint(a), uint(b), uint(d)})
+	})
+}
+
+func FuzzConvert(f *testing.F) {
+	f.Add(0, int64(12345), 1, int64(1253), uint8(dough.HalfUp))
+	f.Add(2, int64(-5), 0, int64(5), uint8(dough.HalfEven))
+	f.Add(1, int64(1), 2, int64(1), uint8(dough.Floor))
+	f.Add(0, int64(math.MaxInt64), 1, int64(1000000), uint8(dough.Up))
+	f.Fuzz(func(t *testing.T, xc int, x int64, to int, rate int64, mode uint8) {
+		// The rate is in ten-thousandths, like many published rates.
+		rates := dough.RateFunc(func(from, to string) (*big.Rat, error) {
+			if rate <= 0 {
+				return nil, fmt.Errorf("no rate")
+			}
+			return big.NewRat(rate, 10000), nil
+		})
+		cur := currencies[int(uint(to)%uint(len(currencies)))]
+		CheckConvert(t, money(xc, x), cur, rates, dough.RoundingMode(mode%7))
+	})
+}
+
+func TestCanRound(t *testing.T) {
+	var cases = []struct {
+		r      string
+		places int
+		mode   dough.RoundingMode
+		want   string
+	}{
+		{"1.005", 2, dough.HalfUp, "1.01"},
+		{"-1.005", 2, dough.HalfUp, "-1.01"},
+		{"1.005", 2, dough.HalfDown, "1"},
+		{"1.005", 2, dough.HalfEven, "1"},
+		{"1.015", 2, dough.HalfEven, "1.02"},
+		{"-1.015", 2, dough.HalfEven, "-1.02"},
+		{"1.001", 2, dough.Up, "1.01"},
+		{"-1.001", 2, dough.Up, "-1.01"},
+		{"-1.009", 2, dough.Down, "-1"},
+		{"-1.009", 2, dough.Ceiling, "-1"},
+		{"-1.001", 2, dough.Floor, "-1.01"},
+		{"2/3", 0, dough.HalfUp, "1"},
+		{"7", 0, dough.Floor, "7"},
+	}
+	for _, c := range cases {
+		r, _ := new(big.Rat).SetString(c.r)
+		want, _ := new(big.Rat).SetString(c.want)
+		if got := Round(r, c.places, c.mode); got.Cmp(want) != 0 {
+			t.Errorf("Round(%s, %d, %v): wanted %s, got %s", c.r, c.places, c.mode, c.want, got.RatString())
+		}
+	}
+}
+
+// recorder is a testing.TB that records failures instead of reporting them, to test that the
+// checks catch wrong results.
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failed = true
+}
+
+func TestCanDetectWrongResult(t *testing.T) {
+	var cases = []struct {
+		got  dough.Money
+		err  error
+		cur  string
+		want string
+		fail bool
+	}{
+		{dough.MustNew("GBP", "1.23"), nil, "GBP", "1.23", false},
+		{dough.MustNew("GBP", "1.23"), nil, "GBP", "1.24", true},
+		{dough.MustNew("EUR", "1.23"), nil, "GBP", "1.23", true},
+		{dough.Money{}, fmt.Errorf("oops"), "GBP", "1.23", true},
+		{dough.Money{}, fmt.Errorf("%w", dough.ErrOutOfRange), "GBP", "1e30", false},
+		{dough.Money{}, fmt.Errorf("oops"), "GBP", "1e30", true},
+	}
+	for _, c := range cases {
+		rec := &recorder{TB: t}
+		want, _ := new(big.Rat).SetString(c.want)
+		CheckResult(rec, "test", c.got, c.err, c.cur, want)
+		if rec.failed != c.fail {
+			t.Errorf("CheckResult(%v, %v, %s %s): wanted failure %t, got %t", c.got, c.err, c.cur, c.want, c.fail, rec.failed)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package dough
+
+import "fmt"
+
+// CashDrawer tracks the cash in a till over a shift: the opening float, cash sales and payouts,
+// and the notes and coins counted at the end, so that the drawer can be reconciled.
+type CashDrawer struct {
+	opening Money
+	sales   int
+	payouts int
+	counted *Money
+}
+
+// NewCashDrawer returns a new CashDrawer with the given opening float.
+// It returns an error if the float is negative.
+func NewCashDrawer(opening Money) (*CashDrawer, error) {
+	if opening.a < 0 {
+		return nil, fmt.Errorf("opening float can't be negative, got %v", opening)
+	}
+	return &CashDrawer{opening: opening}, nil
+}
+
+// Currency gets the currency of the drawer.
+func (d *CashDrawer) Currency() string {
+	return d.opening.Currency()
+}
+
+// AddSale records cash taken for a sale.
+// It returns an error if m is negative or in a different currency, or one wrapping ErrOutOfRange
+// if the cash expected in the drawer would overflow.
+func (d *CashDrawer) AddSale(m Money) error {
+	if err := d.check(m); err != nil {
+		return err
+	}
+	// Keep the opening float plus sales in range, so that Expected can't overflow.
+	s, ok := addAtoms(d.sales, m.a)
+	if ok {
+		_, ok = addAtoms(d.opening.a, s)
+	}
+	if !ok {
+		return fmt.Errorf("%w: cash sales of %v", ErrOutOfRange, m)
+	}
+	d.sales = s
+	return nil
+}
+
+// AddPayout records cash paid out of the drawer, e.g. for a refund or petty cash.
+// It returns an error if m is negative or in a different currency, or one wrapping ErrOutOfRange
+// if the total of payouts would overflow.
+func (d *CashDrawer) AddPayout(m Money) error {
+	if err := d.check(m); err != nil {
+		return err
+	}
+	p, ok := addAtoms(d.payouts, m.a)
+	if !ok {
+		return fmt.Errorf("%w: cash payouts of %v", ErrOutOfRange, m)
+	}
+	d.payouts = p
+	return nil
+}
+
+func (d *CashDrawer) check(m Money) error {
+	if m.Currency() != d.Currency() {
+		return fmt.Errorf("Can't add %s to %s cash drawer", m.Currency(), d.Currency())
+	}
+	if m.a < 0 {
+		return fmt.Errorf("amount can't be negative, got %v", m)
+	}
+	return nil
+}
+
+// Opening gets the opening float.
+func (d *CashDrawer) Opening() Money {
+	return d.opening
+}
+
+// Sales gets the total of cash sales.
+func (d *CashDrawer) Sales() Money {
+	return Money{d.opening.c, d.sales}
+}
+
+// Payouts gets the total of cash payouts.
+func (d *CashDrawer) Payouts() Money {
+	return Money{d.opening.c, d.payouts}
+}
+
+// Expected returns the cash that should be in the drawer: the opening float plus sales, less payouts.
+func (d *CashDrawer) Expected() Money {
+	// The float plus sales and the payouts are each between zero and maxAtoms, so this can't overflow.
+	return Money{d.opening.c, d.opening.a + d.sales - d.payouts}
+}
+
+// Count records the notes and coins counted in the drawer, as a count of each denomination,
+// and returns their total. If denominations are registered for the drawer's currency,
+// each denomination must be one of them.
+// It returns an error if a denomination is invalid, or a count is negative, or one wrapping
+// ErrOutOfRange if the total would overflow.
+func (d *CashDrawer) Count(counts map[Money]int) (Money, error) {
+	set, checkSet := DenominationsFor(d.Currency())
+	valid := map[Money]bool{}
+	for _, v := range set.values {
+		valid[v] = true
+	}
+	total := 0
+	for v, n := range counts {
+		if v.Currency() != d.Currency() || v.a <= 0 || (checkSet && !valid[v]) {
+			return Money{}, fmt.Errorf("invalid denomination %v for %s cash drawer", v, d.Currency())
+		}
+		if n < 0 {
+			return Money{}, fmt.Errorf("count of %v can't be negative, got %d", v, n)
+		}
+		z, ok := mulAtoms(v.a, n)
+		if ok {
+			total, ok = addAtoms(total, z)
+		}
+		if !ok {
+			return Money{}, fmt.Errorf("%w: count of %d × %v", ErrOutOfRange, n, v)
+		}
+	}
+	c := Money{d.opening.c, total}
+	d.counted = &c
+	return c, nil
+}
+
+// Counted returns the total counted by the last call to Count.
+// ok is false if the drawer hasn't been counted.
+func (d *CashDrawer) Counted() (m Money, ok bool) {
+	if d.counted == nil {
+		return Money{}, false
+	}
+	return *d.counted, true
+}
+
+// OverShort returns the difference between the counted and expected cash.
+// It is positive if the drawer is over, and negative if it is short.
+// It returns an error if the drawer hasn't been counted, or one wrapping ErrOutOfRange if the
+// difference would overflow.
+func (d *CashDrawer) OverShort() (Money, error) {
+	if d.counted == nil {
+		return Money{}, fmt.Errorf("cash drawer hasn't been counted")
+	}
+	return d.counted.Sub(d.Expected())
+}
@@ -0,0 +1,108 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func gbp(amt string) Money {
+	m, _ := New("GBP", amt)
+	return m
+}
+
+func TestCanReconcileCashDrawer(t *testing.T) {
+	var cases = []struct {
+		sales   []string
+		payouts []string
+		counts  map[string]int
+		want    string
+	}{
+		{nil, nil, map[string]int{"50.00": 2}, "0.00"},
+		{[]string{"12.50", "7.49"}, []string{"5.00"}, map[string]int{"50.00": 2, "10.00": 1, "2.00": 2, "0.50": 1, "0.20": 2, "0.05": 1, "0.02": 2}, "0.00"},
+		{[]string{"12.50"}, nil, map[string]int{"50.00": 2, "10.00": 1, "2.00": 1}, "-0.50"},
+		{nil, []string{"20.00"}, map[string]int{"50.00": 1, "20.00": 1, "10.00": 1}, "0.00"},
+		{nil, nil, map[string]int{"50.00": 2, "0.01": 3}, "0.03"},
+	}
+	for _, c := range cases {
+		d, err := NewCashDrawer(gbp("100.00"))
+		if err != nil {
+			t.Fatalf("error received from NewCashDrawer, none expected %v", err)
+		}
+		for _, s := range c.sales {
+			if err := d.AddSale(gbp(s)); err != nil {
+				t.Errorf("error received from AddSale(%s), none expected %v", s, err)
+			}
+		}
+		for _, p := range c.payouts {
+			if err := d.AddPayout(gbp(p)); err != nil {
+				t.Errorf("error received from AddPayout(%s), none expected %v", p, err)
+			}
+		}
+		if _, err := d.OverShort(); err == nil {
+			t.Errorf("error expected from OverShort before counting, none received")
+		}
+		counts := map[Money]int{}
+		for a, n := range c.counts {
+			counts[gbp(a)] = n
+		}
+		if _, err := d.Count(counts); err != nil {
+			t.Errorf("error received from Count(%v), none expected %v", c.counts, err)
+		}
+		if got, _ := d.OverShort(); got.Amount() != c.want {
+			t.Errorf("sales %v, payouts %v, counted %v: wanted %s, got %s", c.sales, c.payouts, c.counts, c.want, got.Amount())
+		}
+	}
+}
+
+func TestCanRejectBadCashDrawerEntries(t *testing.T) {
+	if _, err := NewCashDrawer(gbp("-1.00")); err == nil {
+		t.Errorf("error expected from negative float, none received")
+	}
+	d, _ := NewCashDrawer(gbp("100.00"))
+	eur, _ := New("EUR", "1.00")
+	if err := d.AddSale(eur); err == nil {
+		t.Errorf("error expected adding EUR sale, none received")
+	}
+	if err := d.AddPayout(gbp("-1.00")); err == nil {
+		t.Errorf("error expected adding negative payout, none received")
+	}
+	for _, counts := range []map[Money]int{
+		{gbp("3.00"): 1},
+		{eur: 1},
+		{gbp("1.00"): -1},
+	} {
+		if _, err := d.Count(counts); err == nil {
+			t.Errorf("error expected from Count(%v), none received", counts)
+		}
+	}
+	if _, ok := d.Counted(); ok {
+		t.Errorf("wanted drawer not counted after failed counts")
+	}
+}
+
+func TestCanRejectCashDrawerOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	d, _ := NewCashDrawer(gbp("1.00"))
+	if err := d.AddSale(hi); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AddSale(%v) with a £1 float: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if err := d.AddPayout(hi); err != nil {
+		t.Errorf("error received from AddPayout(%v), none expected %v", hi, err)
+	}
+	if err := d.AddPayout(gbp("0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AddPayout(0.01) after %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if got := d.Expected(); got.Amount() != "-92233720368547757.07" {
+		t.Errorf("wanted expected cash -92233720368547757.07, got %v", got)
+	}
+	if _, err := d.Count(map[Money]int{gbp("50.00"): math.MaxInt / 1000}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Count of too many notes: wanted ErrOutOfRange, got %v", err)
+	}
+	if _, err := d.Count(map[Money]int{gbp("50.00"): math.MaxInt / 5000}); err != nil {
+		t.Fatalf("error received from Count, none expected %v", err)
+	}
+	if _, err := d.OverShort(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("OverShort: wanted ErrOutOfRange, got %v", err)
+	}
+}
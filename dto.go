@@ -0,0 +1,108 @@
+package dough
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MoneyDTO is the canonical wire representation of Money, for use in API request and response bodies.
+// Its JSON form is, e.g. {"currency":"GBP","amount":"123.45"}.
+type MoneyDTO struct {
+	// Currency is the 3-letter ISO 4217 currency code.
+	Currency string `json:"currency"`
+	// Amount is the decimal amount, as returned by Money.Amount.
+	Amount string `json:"amount"`
+}
+
+// DTO returns the MoneyDTO representation of the Money.
+func (x Money) DTO() MoneyDTO {
+	return MoneyDTO{
+		Currency: x.Currency(),
+		Amount:   x.Amount(),
+	}
+}
+
+// FromDTO returns a new Money from its MoneyDTO representation.
+// It returns an error if New would return an error.
+func FromDTO(d MoneyDTO) (Money, error) {
+	return New(d.Currency, d.Amount)
+}
+
+// NumberDTO is like MoneyDTO, but with the amount as a JSON number rather than a string,
+// e.g. {"currency":"GBP","amount":123.45}, for consumers that require it. Prefer MoneyDTO where possible.
+//
+// Amounts are written with exactly the digits returned by Money.Amount, so encoding is exact.
+// However, many JSON decoders, including JavaScript's, read numbers as binary floating point,
+// which can't represent most decimal amounts exactly, e.g. 0.1, and can't represent integers
+// above 2^53 at all, so consumers may see slightly different values.
+type NumberDTO struct {
+	// Currency is the 3-letter ISO 4217 currency code.
+	Currency string `json:"currency"`
+	// Amount is the decimal amount, as returned by Money.Amount.
+	Amount json.Number `json:"amount"`
+}
+
+// NumberDTO returns the NumberDTO representation of the Money.
+func (x Money) NumberDTO() NumberDTO {
+	return NumberDTO{
+		Currency: x.Currency(),
+		Amount:   json.Number(x.Amount()),
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Unlike the default for json.Number,
+// it requires the amount to be present and a JSON number, rejecting a string, null, boolean, object or array.
+func (d *NumberDTO) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Currency string          `json:"currency"`
+		Amount   json.RawMessage `json:"amount"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw.Amount) == 0 {
+		return fmt.Errorf("couldn't unmarshal amount: missing")
+	}
+	if c := raw.Amount[0]; !json.Valid(raw.Amount) || c != '-' && !isDigit(c) {
+		return fmt.Errorf("couldn't unmarshal amount: expected JSON number, got %s", raw.Amount)
+	}
+	d.Currency = raw.Currency
+	d.Amount = json.Number(raw.Amount)
+	return nil
+}
+
+// FromNumberDTO returns a new Money from its NumberDTO representation.
+// As JSON numbers don't preserve trailing zeros, the amount may have fewer decimal places than
+// the currency's minor unit, e.g. 123.4 for £123.40. Otherwise it is validated as strictly as by New:
+// exponents, e.g. 1.2345e2, and more decimal places than the currency's minor unit are rejected.
+// It returns an error if the currency or amount is invalid.
+func FromNumberDTO(d NumberDTO) (Money, error) {
+	return NewWithOptions(d.Currency, string(d.Amount), ParseOptions{AllowMissingMinor: true})
+}
+
+const moneyDTOSchema = `{
+  "type": "object",
+  "description": "A monetary amount.",
+  "required": ["currency", "amount"],
+  "properties": {
+    "currency": {
+      "type": "string",
+      "description": "3-letter ISO 4217 currency code.",
+      "pattern": "^[A-Z]{3}$",
+      "example": "GBP"
+    },
+    "amount": {
+      "type": "string",
+      "description": "Decimal amount in major units, with the currency's number of minor digits.",
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?$",
+      "example": "123.45"
+    }
+  },
+  "additionalProperties": false
+}`
+
+// MoneyDTOSchema returns the JSON Schema for MoneyDTO.
+// It is also a valid OpenAPI 3 Schema Object, so can be used directly under components/schemas.
+func MoneyDTOSchema() json.RawMessage {
+	return json.RawMessage(moneyDTOSchema)
+}
@@ -0,0 +1,144 @@
+package dough
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestCanConvertToAndFromDTO(t *testing.T) {
+	x, _ := New("GBP", "-123.45")
+	b, _ := json.Marshal(x.DTO())
+	if want := `{"currency":"GBP","amount":"-123.45"}`; string(b) != want {
+		t.Errorf("wanted %s, got %s", want, b)
+	}
+	var d MoneyDTO
+	json.Unmarshal(b, &d)
+	got, err := FromDTO(d)
+	if err != nil {
+		t.Errorf("error received from FromDTO(%v), none expected %v", d, err)
+	}
+	if got != x {
+		t.Errorf("wanted %v, got %v", x, got)
+	}
+	if _, err := FromDTO(MoneyDTO{Currency: "GBP"}); err == nil {
+		t.Errorf("error expected from FromDTO with no amount, none received")
+	}
+}
+
+func TestCanConvertToAndFromNumberDTO(t *testing.T) {
+	var cases = []struct {
+		m    Money
+		json string
+	}{
+		{MustNew("GBP", "-123.45"), `{"currency":"GBP","amount":-123.45}`},
+		{MustNew("GBP", "0.10"), `{"currency":"GBP","amount":0.10}`},
+		{MustNew("JPY", "500"), `{"currency":"JPY","amount":500}`},
+		{MustNew("KWD", "1.005"), `{"currency":"KWD","amount":1.005}`},
+	}
+	for _, c := range cases {
+		b, err := json.Marshal(c.m.NumberDTO())
+		if err != nil || string(b) != c.json {
+			t.Errorf("marshaling %v: wanted %s, got %s (%v)", c.m, c.json, b, err)
+		}
+		var d NumberDTO
+		if err := json.Unmarshal(b, &d); err != nil {
+			t.Errorf("error received unmarshaling %s, none expected %v", b, err)
+			continue
+		}
+		if got, err := FromNumberDTO(d); err != nil || got != c.m {
+			t.Errorf("FromNumberDTO(%v): wanted %v, got %v (%v)", d, c.m, got, err)
+		}
+	}
+}
+
+func TestCanUnmarshalNumberDTOWithoutTrailingZeros(t *testing.T) {
+	var cases = []struct {
+		json string
+		want string
+	}{
+		{`{"currency":"GBP","amount":123.4}`, "GBP 123.40"},
+		{`{"currency":"GBP","amount":123}`, "GBP 123.00"},
+		{`{"currency":"GBP", "amount": 0.1 }`, "GBP 0.10"},
+	}
+	for _, c := range cases {
+		var d NumberDTO
+		if err := json.Unmarshal([]byte(c.json), &d); err != nil {
+			t.Errorf("error received unmarshaling %s, none expected %v", c.json, err)
+			continue
+		}
+		if got, err := FromNumberDTO(d); err != nil || got.String() != c.want {
+			t.Errorf("FromNumberDTO(%s): wanted %s, got %v (%v)", c.json, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadNumberDTO(t *testing.T) {
+	var cases = []string{
+		`{"currency":"GBP","amount":"123.45"}`,
+		`{"currency":"GBP","amount":1.2345e2}`,
+		`{"currency":"GBP","amount":123.456}`,
+		`{"currency":"GBP","amount":null}`,
+		`{"currency":"GBP"}`,
+		`{"currency":"FOO","amount":1}`,
+		`{"currency":"GBP","amount":[1]}`,
+	}
+	for _, c := range cases {
+		var d NumberDTO
+		if err := json.Unmarshal([]byte(c), &d); err != nil {
+			continue
+		}
+		if got, err := FromNumberDTO(d); err == nil {
+			t.Errorf("error expected from %s, none received, got %v", c, got)
+		}
+	}
+}
+
+func TestCanRejectNonNumberAmountInNumberDTO(t *testing.T) {
+	var cases = []string{
+		`{"currency":"GBP"}`,
+		`{"currency":"GBP","amount":null}`,
+		`{"currency":"GBP","amount":true}`,
+		`{"currency":"GBP","amount":false}`,
+		`{"currency":"GBP","amount":"123.45"}`,
+		`{"currency":"GBP","amount":{}}`,
+		`{"currency":"GBP","amount":{"value":1}}`,
+		`{"currency":"GBP","amount":[]}`,
+		`{"currency":"GBP","amount":[1]}`,
+	}
+	for _, c := range cases {
+		var d NumberDTO
+		if err := json.Unmarshal([]byte(c), &d); err == nil {
+			t.Errorf("error expected from Unmarshal(%s), none received, got %+v", c, d)
+		}
+	}
+	var d NumberDTO
+	if err := json.Unmarshal([]byte(`{"currency":"GBP","amount":-0.5}`), &d); err != nil || d.Amount != "-0.5" {
+		t.Errorf("Unmarshal of negative amount: wanted -0.5, got %+v (%v)", d, err)
+	}
+}
+
+func TestCanGetDTOSchema(t *testing.T) {
+	var s struct {
+		Required   []string
+		Properties map[string]struct {
+			Pattern string
+			Example string
+		}
+	}
+	if err := json.Unmarshal(MoneyDTOSchema(), &s); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	if len(s.Required) != 2 {
+		t.Errorf("wanted 2 required properties, got %v", s.Required)
+	}
+	x, _ := New("GBP", "-123.45")
+	d := x.DTO()
+	for name, v := range map[string]string{"currency": d.Currency, "amount": d.Amount} {
+		p := s.Properties[name]
+		re := regexp.MustCompile(p.Pattern)
+		if !re.MatchString(v) || !re.MatchString(p.Example) {
+			t.Errorf("%s pattern %s doesn't match %q and %q", name, p.Pattern, v, p.Example)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// Eval evaluates an arithmetic expression over Money values, e.g. "subtotal * 0.2 + shipping".
+//
+// Expressions may contain decimal numbers, variables from vars, the operators + - * /,
+// and parentheses, nested at most 100 deep. Amounts may only be added to or subtracted from
+// amounts in the same currency, and may be multiplied or divided by numbers. Dividing one
+// amount by another gives a number.
+//
+// Intermediate results are exact. If the result is not a whole number of minor units
+// it must be rounded explicitly with round(x, mode), where mode is the name of a
+// RoundingMode, e.g. "round(subtotal * 0.175, HalfEven)". Otherwise, an error is returned.
+//
+// It returns an error if the expression is malformed or too deeply nested, refers to an unknown
+// variable, mixes currencies, divides by zero, or doesn't evaluate to an amount of money.
+func Eval(expr string, vars map[string]Money) (Money, error) {
+	p := &evalParser{s: expr, vars: vars}
+	v, err := p.expr()
+	if err != nil {
+		return Money{}, err
+	}
+	if p.skipSpace(); p.i < len(p.s) {
+		return Money{}, p.errorf("unexpected %q", p.s[p.i])
+	}
+	if !v.money {
+		return Money{}, fmt.Errorf("expression %q is a number, not an amount of money", expr)
+	}
+	if !v.r.IsInt() {
+		return Money{}, fmt.Errorf("expression %q requires rounding: use round(x, mode)", expr)
+	}
+	a, err := roundAtoms(v.r, Down)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{
+		v.c,
+		a,
+	}, nil
+}
+
+// evalValue is an intermediate result: either a plain number,
+// or an exact number of minor units of a currency.
+type evalValue struct {
+	money bool
+	c     currency.Unit
+	r     *big.Rat
+}
+
+// maxEvalDepth is the deepest nesting of parentheses, round calls and negations Eval accepts,
+// so that a hostile expression can't exhaust the stack.
+const maxEvalDepth = 100
+
+type evalParser struct {
+	s     string
+	i     int
+	vars  map[string]Money
+	depth int
+}
+
+// enter records a level of nesting, returning an error if there are too many.
+// Each successful call must be matched by a call to leave.
+func (p *evalParser) enter() error {
+	if p.depth == maxEvalDepth {
+		return p.errorf("nested more than %d deep", maxEvalDepth)
+	}
+	p.depth++
+	return nil
+}
+
+func (p *evalParser) leave() {
+	p.depth--
+}
+
+func (p *evalParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid expression at offset %d: %s", p.i, fmt.Sprintf(format, args...))
+}
+
+func (p *evalParser) skipSpace() {
+	for p.i < len(p.s) && (p.s[p.i] == ' ' || p.s[p.i] == '\t' || p.s[p.i] == '\n' || p.s[p.i] == '\r') {
+		p.i++
+	}
+}
+
+// peek returns the next non-space byte, or 0 at the end of the input.
+func (p *evalParser) peek() byte {
+	p.skipSpace()
+	if p.i == len(p.s) {
+		return 0
+	}
+	return p.s[p.i]
+}
+
+// expr := term (('+' | '-') term)*
+func (p *evalParser) expr() (evalValue, error) {
+	x, err := p.term()
+	if err != nil {
+		return x, err
+	}
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return x, nil
+		}
+		p.i++
+		y, err := p.term()
+		if err != nil {
+			return x, err
+		}
+		if x.money != y.money {
+			return x, p.errorf("can't add or subtract a number and an amount of money")
+		}
+		if x.money && x.c != y.c {
+			return x, p.errorf("can't add or subtract different currencies (%s and %s)", x.c, y.c)
+		}
+		if op == '+' {
+			x.r = new(big.Rat).Add(x.r, y.r)
+		} else {
+			x.r = new(big.Rat).Sub(x.r, y.r)
+		}
+	}
+}
+
+// term := unary (('*' | '/') unary)*
+func (p *evalParser) term() (evalValue, error) {
+	x, err := p.unary()
+	if err != nil {
+		return x, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return x, nil
+		}
+		p.i++
+		y, err := p.unary()
+		if err != nil {
+			return x, err
+		}
+		if op == '*' {
+			if x.money && y.money {
+				return x, p.errorf("can't multiply two amounts of money")
+			}
+			if y.money {
+				x.money, x.c = true, y.c
+			}
+			x.r = new(big.Rat).Mul(x.r, y.r)
+			continue
+		}
+		if y.r.Sign() == 0 {
+			return x, p.errorf("division by zero")
+		}
+		if y.money {
+			if !x.money {
+				return x, p.errorf("can't divide a number by an amount of money")
+			}
+			if x.c != y.c {
+				return x, p.errorf("can't divide different currencies (%s and %s)", x.c, y.c)
+			}
+			x.money = false
+		}
+		x.r = new(big.Rat).Quo(x.r, y.r)
+	}
+}
+
+// unary := '-' unary | primary
+func (p *evalParser) unary() (evalValue, error) {
+	if p.peek() == '-' {
+		if err := p.enter(); err != nil {
+			return evalValue{}, err
+		}
+		defer p.leave()
+		p.i++
+		x, err := p.unary()
+		if err != nil {
+			return x, err
+		}
+		x.r = new(big.Rat).Neg(x.r)
+		return x, nil
+	}
+	return p.primary()
+}
+
+// primary := number | variable | 'round' '(' expr ',' mode ')' | '(' expr ')'
+func (p *evalParser) primary() (evalValue, error) {
+	switch c := p.peek(); {
+	case c == '(':
+		if err := p.enter(); err != nil {
+			return evalValue{}, err
+		}
+		defer p.leave()
+		p.i++
+		x, err := p.expr()
+		if err != nil {
+			return x, err
+		}
+		if p.peek() != ')' {
+			return x, p.errorf("expected ')'")
+		}
+		p.i++
+		return x, nil
+	case isDigit(c) || c == '.':
+		start := p.i
+		for p.i < len(p.s) && (isDigit(p.s[p.i]) || p.s[p.i] == '.') {
+			p.i++
+		}
+		r, ok := new(big.Rat).SetString(p.s[start:p.i])
+		if !ok {
+			return evalValue{}, p.errorf("invalid number %q", p.s[start:p.i])
+		}
+		return evalValue{r: r}, nil
+	case isIdentStart(c):
+		name := p.ident()
+		if name == "round" {
+			return p.round()
+		}
+		m, ok := p.vars[name]
+		if !ok {
+			return evalValue{}, p.errorf("unknown variable %q", name)
+		}
+		return evalValue{money: true, c: m.c, r: new(big.Rat).SetInt64(int64(m.a))}, nil
+	case c == 0:
+		return evalValue{}, p.errorf("unexpected end of expression")
+	default:
+		return evalValue{}, p.errorf("unexpected %q", c)
+	}
+}
+
+// round parses the arguments of round(x, mode), having consumed "round".
+func (p *evalParser) round() (evalValue, error) {
+	if p.peek() != '(' {
+		return evalValue{}, p.errorf("expected '(' after round")
+	}
+	if err := p.enter(); err != nil {
+		return evalValue{}, err
+	}
+	defer p.leave()
+	p.i++
+	x, err := p.expr()
+	if err != nil {
+		return x, err
+	}
+	if p.peek() != ',' {
+		return x, p.errorf("expected ',' in round")
+	}
+	p.i++
+	if !isIdentStart(p.peek()) {
+		return x, p.errorf("expected rounding mode")
+	}
+	name := p.ident()
+	mode := RoundingMode(-1)
+	for m, n := range roundingModeNames {
+		if n == name {
+			mode = RoundingMode(m)
+		}
+	}
+	if mode < 0 {
+		return x, p.errorf("unknown rounding mode %q", name)
+	}
+	if p.peek() != ')' {
+		return x, p.errorf("expected ')'")
+	}
+	p.i++
+	if !x.money {
+		return x, p.errorf("can only round amounts of money")
+	}
+	x.r = new(big.Rat).SetInt(roundRat(x.r, mode))
+	return x, nil
+}
+
+func (p *evalParser) ident() string {
+	start := p.i
+	for p.i < len(p.s) && (isIdentStart(p.s[p.i]) || isDigit(p.s[p.i])) {
+		p.i++
+	}
+	return p.s[start:p.i]
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
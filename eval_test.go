@@ -0,0 +1,110 @@
+package dough
+
+import (
+	"strings"
+	"testing"
+)
+
+func evalVars() map[string]Money {
+	subtotal, _ := New("GBP", "100.00")
+	shipping, _ := New("GBP", "4.99")
+	odd, _ := New("GBP", "0.05")
+	fee, _ := New("EUR", "1.00")
+	return map[string]Money{
+		"subtotal": subtotal,
+		"shipping": shipping,
+		"odd":      odd,
+		"fee_eur":  fee,
+	}
+}
+
+func TestCanEval(t *testing.T) {
+	var cases = []struct {
+		expr string
+		want string
+	}{
+		{"subtotal", "100.00"},
+		{"subtotal * 0.2 + shipping", "24.99"},
+		{"shipping + subtotal * 0.2", "24.99"},
+		{"(subtotal + shipping) * 2", "209.98"},
+		{"2 * subtotal", "200.00"},
+		{"-subtotal", "-100.00"},
+		{"subtotal - -shipping", "104.99"},
+		{"subtotal / 4", "25.00"},
+		{"subtotal * (shipping / subtotal)", "4.99"},
+		{"round(odd / 2, HalfUp)", "0.03"},
+		{"round(odd / 2, HalfEven)", "0.02"},
+		{"round(odd / 2, Down)", "0.02"},
+		{"round(-odd / 2, HalfUp)", "-0.03"},
+		{"round(shipping * 0.175, HalfEven) + shipping", "5.86"},
+		{"  subtotal\t*\n.5  ", "50.00"},
+	}
+	for _, c := range cases {
+		got, err := Eval(c.expr, evalVars())
+		if err != nil {
+			t.Errorf("error received from Eval(%q), none expected %v", c.expr, err)
+			continue
+		}
+		if got.Currency() != "GBP" || got.Amount() != c.want {
+			t.Errorf("Eval(%q): wanted GBP %s, got %v", c.expr, c.want, got)
+		}
+	}
+}
+
+func TestCanRejectBadEval(t *testing.T) {
+	var cases = []struct {
+		expr string
+	}{
+		{""},
+		{"subtotal +"},
+		{"subtotal + fee_eur"},
+		{"subtotal + 1"},
+		{"subtotal * shipping"},
+		{"1 / subtotal"},
+		{"subtotal / fee_eur"},
+		{"subtotal / 0"},
+		{"subtotal / (shipping - shipping)"},
+		{"unknown"},
+		{"2 * 3"},
+		{"subtotal / shipping"},
+		{"odd / 2"},
+		{"round(odd / 2)"},
+		{"round(odd / 2, Sideways)"},
+		{"round(0.5, HalfUp)"},
+		{"(subtotal"},
+		{"subtotal)"},
+		{"subtotal $ 2"},
+		{"1.2.3 * subtotal"},
+	}
+	for _, c := range cases {
+		if got, err := Eval(c.expr, evalVars()); err == nil {
+			t.Errorf("error expected from Eval(%q), none received, got %v", c.expr, got)
+		}
+	}
+}
+
+func TestCanLimitEvalNesting(t *testing.T) {
+	var cases = []struct {
+		expr string
+		ok   bool
+	}{
+		{strings.Repeat("(", 100) + "subtotal" + strings.Repeat(")", 100), true},
+		{strings.Repeat("-", 100) + "subtotal", true},
+		{strings.Repeat("(-", 50) + "subtotal" + strings.Repeat(")", 50), true},
+		{strings.Repeat("(", 101) + "subtotal" + strings.Repeat(")", 101), false},
+		{strings.Repeat("-", 101) + "subtotal", false},
+		{strings.Repeat("round(", 101) + "subtotal" + strings.Repeat(", Down)", 101), false},
+		{strings.Repeat("(", 1000000), false},
+		// Depth is nesting, not the number of parentheses.
+		{strings.Repeat("(subtotal) + ", 200) + "subtotal", true},
+	}
+	for _, c := range cases {
+		_, err := Eval(c.expr, evalVars())
+		if (err == nil) != c.ok {
+			t.Errorf("Eval(%.20q...): wanted ok %t, got %v", c.expr, c.ok, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "nested more than 100 deep") {
+			t.Errorf("Eval(%.20q...): wanted nesting error, got %v", c.expr, err)
+		}
+	}
+}
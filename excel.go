@@ -0,0 +1,41 @@
+package dough
+
+// ExcelStyle determines how ExcelAmount protects an amount from being
+// reinterpreted when a CSV file is opened in a spreadsheet.
+type ExcelStyle int
+
+const (
+	// ExcelPlain emits the amount as returned by Amount, e.g. 123.45,
+	// with an explicit decimal point and no grouping, whatever the locale.
+	// Spreadsheets will treat it as a number, so may drop trailing zeros.
+	ExcelPlain ExcelStyle = iota
+	// ExcelApostrophe prefixes the amount with an apostrophe, e.g. '123.45,
+	// which spreadsheet tools treat as a marker that the value is text.
+	ExcelApostrophe
+	// ExcelFormula wraps the amount in a string formula, e.g. ="123.45",
+	// which Excel displays exactly as written when opening a CSV file.
+	ExcelFormula
+)
+
+// ExcelAmount returns the amount of the Money in a form that won't be mangled by Excel.
+func (x Money) ExcelAmount(style ExcelStyle) string {
+	b := make([]byte, 0, 28)
+	switch style {
+	case ExcelApostrophe:
+		b = append(b, '\'')
+		b = x.AppendAmount(b)
+	case ExcelFormula:
+		b = append(b, '=', '"')
+		b = x.AppendAmount(b)
+		b = append(b, '"')
+	default:
+		b = x.AppendAmount(b)
+	}
+	return string(b)
+}
+
+// ExcelRecord returns the currency and amount of the Money as separate CSV fields,
+// for writing with encoding/csv.
+func (x Money) ExcelRecord(style ExcelStyle) []string {
+	return []string{x.Currency(), x.ExcelAmount(style)}
+}
@@ -0,0 +1,63 @@
+// Package excel writes Money to spreadsheet cells using github.com/xuri/excelize.
+package excel
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/xuri/excelize/v2"
+)
+
+// SetCell writes m to a cell as a number, with a number format that shows
+// the currency's number of minor digits and its code, e.g. 123.45 "GBP".
+// Amounts are converted to the nearest float64, so amounts with more than
+// 15 significant digits can't be held exactly; Excel itself can't represent them.
+func SetCell(f *excelize.File, sheet, cell string, m dough.Money) error {
+	v, err := strconv.ParseFloat(m.Amount(), 64)
+	if err != nil {
+		return err
+	}
+	if err := f.SetCellFloat(sheet, cell, v, m.Exponent(), 64); err != nil {
+		return err
+	}
+	style, err := f.NewStyle(&excelize.Style{CustomNumFmt: numFmt(m)})
+	if err != nil {
+		return err
+	}
+	return f.SetCellStyle(sheet, cell, cell, style)
+}
+
+// SetCells writes the currency code of m to curCell as text,
+// and its amount to amtCell as a number with the currency's number of minor digits,
+// for sheets that keep currency in a separate column.
+func SetCells(f *excelize.File, sheet, curCell, amtCell string, m dough.Money) error {
+	if err := f.SetCellStr(sheet, curCell, m.Currency()); err != nil {
+		return err
+	}
+	v, err := strconv.ParseFloat(m.Amount(), 64)
+	if err != nil {
+		return err
+	}
+	if err := f.SetCellFloat(sheet, amtCell, v, m.Exponent(), 64); err != nil {
+		return err
+	}
+	code := decimalFmt(m)
+	style, err := f.NewStyle(&excelize.Style{CustomNumFmt: &code})
+	if err != nil {
+		return err
+	}
+	return f.SetCellStyle(sheet, amtCell, amtCell, style)
+}
+
+func decimalFmt(m dough.Money) string {
+	if m.Exponent() == 0 {
+		return "0"
+	}
+	return "0." + strings.Repeat("0", m.Exponent())
+}
+
+func numFmt(m dough.Money) *string {
+	s := decimalFmt(m) + ` "` + m.Currency() + `"`
+	return &s
+}
@@ -0,0 +1,49 @@
+package excel
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCanSetCell(t *testing.T) {
+	var cases = []struct {
+		amt  string
+		want string
+	}{
+		{"1.50", "1.50 GBP"},
+		{"-1234567.89", "-1234567.89 GBP"},
+		{"0.00", "0.00 GBP"},
+	}
+	for _, c := range cases {
+		f := excelize.NewFile()
+		m, _ := dough.New("GBP", c.amt)
+		if err := SetCell(f, "Sheet1", "A1", m); err != nil {
+			t.Fatalf("error received from SetCell, none expected %v", err)
+		}
+		got, _ := f.GetCellValue("Sheet1", "A1")
+		if got != c.want {
+			t.Errorf("wanted %s, got %s", c.want, got)
+		}
+		raw, _ := f.GetCellValue("Sheet1", "A1", excelize.Options{RawCellValue: true})
+		if v, _ := strconv.ParseFloat(raw, 64); strconv.FormatFloat(v, 'f', 2, 64) != c.amt {
+			t.Errorf("raw value: wanted %s, got %s", c.amt, raw)
+		}
+	}
+}
+
+func TestCanSetCells(t *testing.T) {
+	f := excelize.NewFile()
+	m, _ := dough.New("EUR", "2.50")
+	if err := SetCells(f, "Sheet1", "A1", "B1", m); err != nil {
+		t.Fatalf("error received from SetCells, none expected %v", err)
+	}
+	if got, _ := f.GetCellValue("Sheet1", "A1"); got != "EUR" {
+		t.Errorf("wanted EUR, got %s", got)
+	}
+	if got, _ := f.GetCellValue("Sheet1", "B1"); got != "2.50" {
+		t.Errorf("wanted 2.50, got %s", got)
+	}
+}
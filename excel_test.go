@@ -0,0 +1,39 @@
+package dough
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestCanFormatForExcel(t *testing.T) {
+	var cases = []struct {
+		amt   string
+		style ExcelStyle
+		want  string
+	}{
+		{"1.50", ExcelPlain, "1.50"},
+		{"-1234567.00", ExcelPlain, "-1234567.00"},
+		{"1.50", ExcelApostrophe, "'1.50"},
+		{"-0.01", ExcelApostrophe, "'-0.01"},
+		{"1.50", ExcelFormula, `="1.50"`},
+		{"-1234567.00", ExcelFormula, `="-1234567.00"`},
+	}
+	for _, c := range cases {
+		x, _ := New("GBP", c.amt)
+		if got := x.ExcelAmount(c.style); got != c.want {
+			t.Errorf("wanted %s, got %s", c.want, got)
+		}
+	}
+}
+
+func TestCanWriteExcelRecord(t *testing.T) {
+	x, _ := New("GBP", "1.50")
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	w.Write(x.ExcelRecord(ExcelFormula))
+	w.Flush()
+	if want := "GBP,\"=\"\"1.50\"\"\"\n"; b.String() != want {
+		t.Errorf("wanted %q, got %q", want, b.String())
+	}
+}
@@ -0,0 +1,131 @@
+package dough
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/text/currency"
+)
+
+// isoExponents holds the ISO 4217 minor unit of each currency that doesn't have two decimal places.
+// ISO 4217 gives no minor unit for XTS (testing) or XXX (no currency); they have two decimal places,
+// like most currencies, so that they can stand in for a typical currency in tests and placeholders.
+// Nor does it give one for precious metals, which default to four decimal places, e.g. of a troy ounce.
+// https://en.wikipedia.org/wiki/ISO_4217#Treatment_of_minor_currency_units_.28the_.22exponent.22.29
+var isoExponents = map[currency.Unit]int{
+	currency.MustParseISO("BIF"): 0,
+	currency.MustParseISO("CLP"): 0,
+	currency.MustParseISO("DJF"): 0,
+	currency.MustParseISO("GNF"): 0,
+	currency.MustParseISO("ISK"): 0,
+	currency.MustParseISO("JPY"): 0,
+	currency.MustParseISO("KMF"): 0,
+	currency.MustParseISO("KRW"): 0,
+	currency.MustParseISO("PYG"): 0,
+	currency.MustParseISO("RWF"): 0,
+	currency.MustParseISO("UGX"): 0,
+	currency.MustParseISO("UYI"): 0,
+	currency.MustParseISO("VND"): 0,
+	currency.MustParseISO("VUV"): 0,
+	currency.MustParseISO("XAF"): 0,
+	currency.MustParseISO("XOF"): 0,
+	currency.MustParseISO("XPF"): 0,
+	// Withdrawn currencies.
+	currency.MustParseISO("ESP"): 0,
+	currency.MustParseISO("ITL"): 0,
+	currency.MustParseISO("PTE"): 0,
+
+	currency.MustParseISO("BHD"): 3,
+	currency.MustParseISO("IQD"): 3,
+	currency.MustParseISO("JOD"): 3,
+	currency.MustParseISO("KWD"): 3,
+	currency.MustParseISO("LYD"): 3,
+	currency.MustParseISO("OMR"): 3,
+	currency.MustParseISO("TND"): 3,
+
+	currency.MustParseISO("CLF"): 4,
+	currency.MustParseISO("XAG"): 4,
+	currency.MustParseISO("XAU"): 4,
+	currency.MustParseISO("XPD"): 4,
+	currency.MustParseISO("XPT"): 4,
+}
+
+// configurableExponents holds the currencies that ISO 4217 gives no minor unit,
+// whose exponent can be changed with SetExponent: precious metals, SDRs, bond market units and the like.
+var configurableExponents = map[currency.Unit]bool{
+	currency.MustParseISO("XAG"): true,
+	currency.MustParseISO("XAU"): true,
+	currency.MustParseISO("XBA"): true,
+	currency.MustParseISO("XBB"): true,
+	currency.MustParseISO("XBC"): true,
+	currency.MustParseISO("XBD"): true,
+	currency.MustParseISO("XDR"): true,
+	currency.MustParseISO("XPD"): true,
+	currency.MustParseISO("XPT"): true,
+	currency.MustParseISO("XSU"): true,
+	currency.MustParseISO("XTS"): true,
+	currency.MustParseISO("XUA"): true,
+	currency.MustParseISO("XXX"): true,
+}
+
+// exponents holds the current exponent table: isoExponents, with any changes made by SetExponent.
+// The table is replaced rather than modified, so that it can be read without locking.
+// It is initialised here rather than in init, as other package variables are initialised using it.
+var exponents = func() *atomic.Pointer[map[currency.Unit]int] {
+	p := new(atomic.Pointer[map[currency.Unit]int])
+	p.Store(&isoExponents)
+	return p
+}()
+
+// exponentsMu serialises calls to SetExponent.
+var exponentsMu sync.Mutex
+
+// SetExponent sets the number of minor unit digits of a currency that ISO 4217 gives no minor unit,
+// e.g. SetExponent("XAU", 6) to hold gold to a millionth of a troy ounce.
+// It changes the meaning of existing Money in the currency, so should only be called
+// during initialisation, before any Money in the currency is created.
+// It returns an error if cur isn't one of XAG, XAU, XBA, XBB, XBC, XBD, XDR, XPD, XPT, XSU, XTS, XUA or XXX,
+// or if exp isn't between 0 and 18.
+func SetExponent(cur string, exp int) error {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if !configurableExponents[c] {
+		return fmt.Errorf("can't set exponent of %s, which has an ISO 4217 minor unit", cur)
+	}
+	if exp < 0 || exp > maxCurrencyExponent {
+		return fmt.Errorf("exponent must be between 0 and %d, got %d", maxCurrencyExponent, exp)
+	}
+	exponentsMu.Lock()
+	defer exponentsMu.Unlock()
+	old := *exponents.Load()
+	m := make(map[currency.Unit]int, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	m[c] = exp
+	exponents.Store(&m)
+	return nil
+}
+
+// exponent returns the number of minor unit digits of c.
+func exponent(c currency.Unit) int {
+	if e, ok := (*exponents.Load())[c]; ok {
+		return e
+	}
+	return 2
+}
+
+// pow10 holds powers of 10 up to the largest that fits in a uint64.
+// maxCurrencyExponent is the largest exponent a currency can have, the most decimal places an int64 can hold.
+const maxCurrencyExponent = 18
+
+var pow10 = [...]uint64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// zeros is a source of padding for up to 19 minor unit digits.
+const zeros = "0000000000000000000"
@@ -0,0 +1,81 @@
+// Package export formats Money for accounting software imports.
+//
+// Amounts are rendered as plain decimals with an explicit point, no grouping
+// and no currency symbol, with the currency's number of minor digits, up to
+// the maximum the target accepts. Amounts that would need rounding to fit are
+// rejected rather than silently rounded.
+package export
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Maximum number of decimal places accepted in amount fields.
+const (
+	XeroMaxDecimals       = 2
+	QuickBooksMaxDecimals = 2
+)
+
+// Xero returns the amount of m for a Xero bank statement import or API amount field,
+// e.g. "-123.45". Following Xero's convention, money received is positive and money
+// spent is negative, so m should be signed from the account holder's point of view.
+func Xero(m dough.Money) (string, error) {
+	return decimal(m, XeroMaxDecimals)
+}
+
+// QuickBooks returns the amount of m for the single Amount column of a QuickBooks
+// bank transaction import, e.g. "-123.45". Money received is positive and money
+// spent is negative.
+func QuickBooks(m dough.Money) (string, error) {
+	return decimal(m, QuickBooksMaxDecimals)
+}
+
+// QuickBooksDebitCredit returns the amount of m for the separate Debit and Credit
+// columns of a QuickBooks bank transaction import. Both are positive: money spent
+// (a negative m) is written to debit and money received to credit, with the other
+// column left empty. Zero is written as a credit.
+func QuickBooksDebitCredit(m dough.Money) (debit, credit string, err error) {
+	s, err := decimal(m, QuickBooksMaxDecimals)
+	if err != nil {
+		return "", "", err
+	}
+	if s[0] == '-' {
+		return s[1:], "", nil
+	}
+	return "", s, nil
+}
+
+// decimal returns the amount of m with the currency's number of decimals, limited to max.
+func decimal(m dough.Money, max int) (string, error) {
+	u, exp := m.MinorUnits(), m.Exponent()
+	for ; exp > max; exp-- {
+		if u%10 != 0 {
+			return "", fmt.Errorf("%v has more than %d decimal places", m, max)
+		}
+		u /= 10
+	}
+	b := make([]byte, 0, 24)
+	if u < 0 {
+		b = append(b, '-')
+	}
+	d := strconv.AppendUint(nil, abs(u), 10)
+	for len(d) <= exp {
+		d = append([]byte{'0'}, d...)
+	}
+	b = append(b, d[:len(d)-exp]...)
+	if exp > 0 {
+		b = append(b, '.')
+		b = append(b, d[len(d)-exp:]...)
+	}
+	return string(b), nil
+}
+
+func abs(u int64) uint64 {
+	if u < 0 {
+		return uint64(-u)
+	}
+	return uint64(u)
+}
@@ -0,0 +1,65 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanExportForXeroAndQuickBooks(t *testing.T) {
+	var cases = []struct {
+		amt    string
+		want   string
+		debit  string
+		credit string
+	}{
+		{"123.45", "123.45", "", "123.45"},
+		{"-123.45", "-123.45", "123.45", ""},
+		{"0.00", "0.00", "", "0.00"},
+		{"-0.01", "-0.01", "0.01", ""},
+		{"1234567.80", "1234567.80", "", "1234567.80"},
+	}
+	for _, c := range cases {
+		m, _ := dough.New("GBP", c.amt)
+		if got, err := Xero(m); err != nil || got != c.want {
+			t.Errorf("Xero(%s): wanted %s, got %s (%v)", c.amt, c.want, got, err)
+		}
+		if got, err := QuickBooks(m); err != nil || got != c.want {
+			t.Errorf("QuickBooks(%s): wanted %s, got %s (%v)", c.amt, c.want, got, err)
+		}
+		d, cr, err := QuickBooksDebitCredit(m)
+		if err != nil || d != c.debit || cr != c.credit {
+			t.Errorf("QuickBooksDebitCredit(%s): wanted (%q, %q), got (%q, %q) (%v)", c.amt, c.debit, c.credit, d, cr, err)
+		}
+	}
+}
+
+func TestCanLimitDecimals(t *testing.T) {
+	var cases = []struct {
+		cur   string
+		units int64
+		exp   int
+		max   int
+		want  string
+		fails bool
+	}{
+		{"GBP", 12345, 2, 2, "123.45", false},
+		{"GBP", 12340, 2, 1, "123.4", false},
+		{"GBP", 12300, 2, 0, "123", false},
+		{"GBP", 12345, 2, 1, "", true},
+		{"GBP", -5, 2, 2, "-0.05", false},
+	}
+	for _, c := range cases {
+		m, _ := dough.FromScaled(c.cur, c.units, c.exp)
+		got, err := decimal(m, c.max)
+		if c.fails {
+			if err == nil {
+				t.Errorf("error expected formatting %v with %d decimals, none received", m, c.max)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("formatting %v with %d decimals: wanted %s, got %s (%v)", m, c.max, c.want, got, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package dough
+
+import (
+	"fmt"
+
+	"golang.org/x/text/currency"
+)
+
+// ExposureLine is the exposure to one currency, as reported by Bag.Exposure.
+type ExposureLine struct {
+	// Balance is the balance held in the currency.
+	Balance Money
+	// Base is Balance converted to the base currency.
+	Base Money
+	// Percent is Base as a percentage of the gross exposure, the sum of the absolute values of Base
+	// across all lines. It is negative for a short position.
+	Percent float64
+}
+
+// Exposure is a report of the currency exposure of a Bag, as returned by Bag.Exposure.
+type Exposure struct {
+	// Lines is the exposure to each currency, ordered by currency code.
+	Lines []ExposureLine
+	// Net is the sum of Base across all lines.
+	Net Money
+	// Gross is the sum of the absolute values of Base across all lines.
+	Gross Money
+}
+
+// Exposure reports the balance in each currency in the Bag, its equivalent in the base currency
+// using rates, rounded half up, and its percentage of the total.
+// It returns an error if base is not well formed or not recognised, or if a rate isn't available,
+// or one wrapping ErrOutOfRange if the net or gross exposure would overflow.
+func (b Bag) Exposure(base string, rates RateProvider) (Exposure, error) {
+	c, err := currency.ParseISO(base)
+	if err != nil {
+		return Exposure{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	e := Exposure{
+		Net:   Money{c, 0},
+		Gross: Money{c, 0},
+	}
+	for _, m := range b.Balances() {
+		y, err := Convert(m, base, rates, HalfUp)
+		if err != nil {
+			return Exposure{}, err
+		}
+		e.Lines = append(e.Lines, ExposureLine{Balance: m, Base: y})
+		if e.Net, err = e.Net.Add(y); err != nil {
+			return Exposure{}, err
+		}
+		if y.a < 0 {
+			y.a = -y.a
+		}
+		if e.Gross, err = e.Gross.Add(y); err != nil {
+			return Exposure{}, err
+		}
+	}
+	if e.Gross.a != 0 {
+		for i := range e.Lines {
+			e.Lines[i].Percent = percentage(e.Lines[i].Base.a, e.Gross.a)
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,58 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanReportExposure(t *testing.T) {
+	b, _ := NewBag(MustNew("USD", "500.00"), MustNew("GBP", "200.00"), MustNew("JPY", "-15000"), MustNew("EUR", "0.00"))
+	got, err := b.Exposure("USD", testRates)
+	if err != nil {
+		t.Fatalf("error received from Exposure, none expected %v", err)
+	}
+	var want = []struct {
+		balance string
+		base    string
+		percent float64
+	}{
+		{"EUR 0.00", "USD 0.00", 0},
+		{"GBP 200.00", "USD 250.00", 29.41176470588235},
+		{"JPY -15000", "USD -100.00", -11.764705882352942},
+		{"USD 500.00", "USD 500.00", 58.8235294117647},
+	}
+	if len(got.Lines) != len(want) {
+		t.Fatalf("wanted %d lines, got %v", len(want), got.Lines)
+	}
+	for i, w := range want {
+		l := got.Lines[i]
+		if l.Balance.String() != w.balance || l.Base.String() != w.base || l.Percent != w.percent {
+			t.Errorf("line %d: wanted %s, %s, %v, got %v, %v, %v", i, w.balance, w.base, w.percent, l.Balance, l.Base, l.Percent)
+		}
+	}
+	if got.Net.String() != "USD 650.00" || got.Gross.String() != "USD 850.00" {
+		t.Errorf("wanted net USD 650.00, gross USD 850.00, got %v, %v", got.Net, got.Gross)
+	}
+}
+
+func TestCanRejectBadExposure(t *testing.T) {
+	b, _ := NewBag(MustNew("CHF", "1.00"))
+	if _, err := b.Exposure("USD", testRates); err == nil {
+		t.Errorf("error expected from Exposure with missing rate, none received")
+	}
+	if _, err := b.Exposure("FOO", testRates); err == nil {
+		t.Errorf("error expected from Exposure with bad base, none received")
+	}
+	var empty Bag
+	if got, err := empty.Exposure("USD", testRates); err != nil || len(got.Lines) != 0 || got.Net.String() != "USD 0.00" {
+		t.Errorf("wanted empty exposure, got %+v (%v)", got, err)
+	}
+}
+
+func TestCanRejectExposureOverflow(t *testing.T) {
+	hi, _ := MaxValue("USD")
+	b, _ := NewBag(hi, MustNew("GBP", "1.00"))
+	if _, err := b.Exposure("USD", testRates); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Exposure of %v and GBP 1.00: wanted ErrOutOfRange, got %v", hi, err)
+	}
+}
@@ -0,0 +1,21 @@
+package dough
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// BindForm returns a new Money from separate currency and amount fields of HTTP form values
+// or query parameters, e.g. BindForm(r.Form, "currency", "amount").
+// It returns an error if either field is missing, or if New would return an error.
+func BindForm(v url.Values, curField, amtField string) (Money, error) {
+	cur, ok := v[curField]
+	if !ok || len(cur) == 0 || cur[0] == "" {
+		return Money{}, fmt.Errorf("missing currency field %q", curField)
+	}
+	amt, ok := v[amtField]
+	if !ok || len(amt) == 0 || amt[0] == "" {
+		return Money{}, fmt.Errorf("missing amount field %q", amtField)
+	}
+	return New(cur[0], amt[0])
+}
@@ -0,0 +1,37 @@
+package dough
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCanBindForm(t *testing.T) {
+	v, _ := url.ParseQuery("currency=GBP&amount=123.45&currency=EUR")
+	sut, err := BindForm(v, "currency", "amount")
+	if err != nil {
+		t.Errorf("error received from BindForm, none expected %v", err)
+	}
+	if sut.String() != "GBP 123.45" {
+		t.Errorf("wanted GBP 123.45, got %v", sut)
+	}
+}
+
+func TestCanRejectBadForm(t *testing.T) {
+	var cases = []struct {
+		q string
+	}{
+		{""},
+		{"currency=GBP"},
+		{"amount=1.00"},
+		{"currency=&amount=1.00"},
+		{"currency=GBP&amount="},
+		{"currency=FOO&amount=1.00"},
+		{"currency=GBP&amount=1.0.0"},
+	}
+	for _, c := range cases {
+		v, _ := url.ParseQuery(c.q)
+		if _, err := BindForm(v, "currency", "amount"); err == nil {
+			t.Errorf("error expected from BindForm(%q), none received", c.q)
+		}
+	}
+}
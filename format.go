@@ -0,0 +1,200 @@
+package dough
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Digits selects the digits a Formatter uses.
+type Digits int
+
+const (
+	// LocaleDigits uses the locale's default digits, e.g. Arabic-Indic digits for "ar",
+	// but Latin digits for "ar-SA".
+	LocaleDigits Digits = iota
+	// LatinDigits uses the digits 0 to 9.
+	LatinDigits
+	// NativeDigits uses the digits of the locale's script, e.g. Arabic-Indic digits (٠ to ٩)
+	// for any Arabic locale, or Devanagari digits for "hi". Locales whose script has no digits of its own use Latin digits.
+	NativeDigits
+)
+
+// SymbolPosition determines where a Formatter puts the currency symbol.
+type SymbolPosition int
+
+const (
+	// SymbolDefault puts the symbol after the amount in right-to-left locales, and before it otherwise.
+	SymbolDefault SymbolPosition = iota
+	SymbolBefore
+	SymbolAfter
+)
+
+// FormatOptions configures a Formatter. The zero value formats with the locale's digits and
+// separators, and the currency's symbol.
+type FormatOptions struct {
+	Digits   Digits
+	Position SymbolPosition
+	// Code uses the currency code, e.g. "SAR", rather than its symbol.
+	Code bool
+	// NoMarks leaves out Unicode directionality marks, for output that will be laid out by
+	// something that handles bidirectional text itself.
+	NoMarks bool
+}
+
+// Formatter formats Money for display in a locale. Unlike TemplateFuncs, it formats amounts exactly,
+// from their minor units, however large they are.
+//
+// In right-to-left locales, such as Arabic and Hebrew, the result starts with a directionality mark,
+// as do negative amounts in the locale's conventions, so that the amount, its sign and the symbol are
+// displayed in the right order whatever the surrounding text.
+type Formatter struct {
+	printer *message.Printer
+	opts    FormatOptions
+	rtl     bool
+	mark    string
+	// digits are the digits 0 to 9.
+	digits [10]string
+	// decimal and group are the decimal and grouping separators.
+	decimal, group string
+	// primary is the size of the group of digits nearest the decimal separator, and secondary the size
+	// of the groups before it, e.g. 3 and 2 for the Indian lakh and crore grouping, "1,23,45,678".
+	// primary is 0 if the locale doesn't group digits.
+	primary, secondary int
+	// minusPrefix and minusSuffix surround a negative number.
+	minusPrefix, minusSuffix string
+}
+
+// rtlScripts are the scripts written right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true, "Rohg": true, "Samr": true, "Syrc": true, "Thaa": true,
+}
+
+// nativeNumbering gives the numbering system of the digits of each script that has its own,
+// and of the languages that use different digits from the rest of their script.
+var nativeNumbering = map[string]string{
+	"Arab": "arab", "Beng": "beng", "Deva": "deva", "Gujr": "gujr", "Guru": "guru", "Khmr": "khmr",
+	"Knda": "knda", "Laoo": "laoo", "Mlym": "mlym", "Mymr": "mymr", "Orya": "orya", "Telu": "telu",
+	"Thai": "thai", "Tibt": "tibt",
+	"fa": "arabext", "ps": "arabext", "ur": "arabext",
+}
+
+// NewFormatter returns a Formatter for the locale tag.
+func NewFormatter(tag language.Tag, opts FormatOptions) Formatter {
+	script, _ := tag.Script()
+	base, _ := tag.Base()
+	switch opts.Digits {
+	case LatinDigits:
+		tag, _ = tag.SetTypeForKey("nu", "latn")
+	case NativeDigits:
+		nu, ok := nativeNumbering[base.String()]
+		if !ok {
+			nu, ok = nativeNumbering[script.String()]
+		}
+		if !ok {
+			nu = "latn"
+		}
+		tag, _ = tag.SetTypeForKey("nu", nu)
+	}
+	f := Formatter{
+		printer: message.NewPrinter(tag),
+		opts:    opts,
+		rtl:     rtlScripts[script.String()],
+		mark:    "\u200f", // RIGHT-TO-LEFT MARK
+	}
+	if script.String() == "Arab" {
+		f.mark = "\u061c" // ARABIC LETTER MARK
+	}
+	// Find the locale's symbols by formatting numbers made of known digits.
+	for d := range f.digits {
+		f.digits[d] = f.printer.Sprint(number.Decimal(d))
+	}
+	s := f.printer.Sprint(number.Decimal(1.5, number.Scale(1)))
+	f.decimal = strings.TrimSuffix(strings.TrimPrefix(s, f.digits[1]), f.digits[5])
+	s = f.printer.Sprint(number.Decimal(10000000))
+	s = strings.NewReplacer(f.digits[0], "0", f.digits[1], "1").Replace(s)
+	if g := strings.Trim(strings.TrimPrefix(s, "1"), "0"); g != "" {
+		f.group, _, _ = strings.Cut(g, "0")
+		groups := strings.Split(s, f.group)
+		f.primary = len(groups[len(groups)-1])
+		f.secondary = len(groups[len(groups)-2])
+		if len(groups) == 2 {
+			f.secondary = f.primary
+		}
+	}
+	f.minusPrefix, f.minusSuffix, _ = strings.Cut(f.printer.Sprint(number.Decimal(-1)), f.digits[1])
+	if opts.NoMarks {
+		f.minusPrefix = stripMarks(f.minusPrefix)
+		f.minusSuffix = stripMarks(f.minusSuffix)
+	}
+	return f
+}
+
+// stripMarks removes directionality marks from s.
+func stripMarks(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\u200e', '\u200f', '\u061c':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Format returns x formatted for the Formatter's locale, e.g. "£1,234.56", "₹1,23,456.78" in Indian English,
+// or "١٬٢٣٤٫٥٠ ر.س." in Arabic.
+func (f Formatter) Format(x Money) string {
+	var num strings.Builder
+	whole, frac, _ := strings.Cut(strings.TrimPrefix(x.Amount(), "-"), ".")
+	for i := range len(whole) {
+		if n := len(whole) - i; i > 0 && f.primary > 0 && (n == f.primary || n > f.primary && (n-f.primary)%f.secondary == 0) {
+			num.WriteString(f.group)
+		}
+		num.WriteString(f.digits[whole[i]-'0'])
+	}
+	if frac != "" {
+		num.WriteString(f.decimal)
+		for i := range len(frac) {
+			num.WriteString(f.digits[frac[i]-'0'])
+		}
+	}
+
+	sym := x.Currency()
+	if !f.opts.Code {
+		sym = f.printer.Sprint(currency.Symbol(x.c))
+	}
+	if f.opts.NoMarks {
+		sym = stripMarks(sym)
+	}
+	after := f.opts.Position == SymbolAfter || f.opts.Position == SymbolDefault && f.rtl
+
+	var b strings.Builder
+	// Don't repeat a mark the locale already starts negative numbers with.
+	if f.rtl && !f.opts.NoMarks && !(x.a < 0 && strings.HasPrefix(f.minusPrefix, f.mark)) {
+		b.WriteString(f.mark)
+	}
+	if x.a < 0 {
+		b.WriteString(f.minusPrefix)
+	}
+	if !after {
+		b.WriteString(sym)
+		// Separate a symbol made of letters, e.g. "CHF", from the number.
+		if r, _ := utf8.DecodeLastRuneInString(sym); unicode.IsLetter(r) {
+			b.WriteString("\u00a0")
+		}
+	}
+	b.WriteString(num.String())
+	if x.a < 0 {
+		b.WriteString(f.minusSuffix)
+	}
+	if after {
+		b.WriteString("\u00a0")
+		b.WriteString(sym)
+	}
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package dough
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestCanFormatForLocale(t *testing.T) {
+	var cases = []struct {
+		tag  string
+		opts FormatOptions
+		m    string
+		want string
+	}{
+		{"en-GB", FormatOptions{}, "GBP 1234.56", "£1,234.56"},
+		{"en-GB", FormatOptions{}, "GBP -1234.56", "-£1,234.56"},
+		{"en", FormatOptions{}, "USD 92233720368547758.07", "$92,233,720,368,547,758.07"},
+		{"ja", FormatOptions{}, "JPY 1234567", "￥1,234,567"},
+		{"de", FormatOptions{}, "EUR 1234567.56", "€1.234.567,56"},
+		{"de", FormatOptions{Position: SymbolAfter}, "EUR 1234567.56", "1.234.567,56\u00a0€"},
+		{"de-CH", FormatOptions{}, "CHF 1234.56", "CHF\u00a01’234.56"},
+		{"en-GB", FormatOptions{Code: true}, "GBP 1234.56", "GBP\u00a01,234.56"},
+		// Indian lakh and crore grouping.
+		{"en-IN", FormatOptions{}, "INR 123456.78", "₹1,23,456.78"},
+		{"en-IN", FormatOptions{}, "INR -12345678.90", "-₹1,23,45,678.90"},
+		{"en-IN", FormatOptions{}, "INR 1000.00", "₹1,000.00"},
+		{"en-IN", FormatOptions{}, "INR 100.00", "₹100.00"},
+		{"hi-IN", FormatOptions{}, "INR 123456.78", "₹1,23,456.78"},
+		{"hi-IN", FormatOptions{Digits: NativeDigits}, "INR 123456.78", "₹१,२३,४५६.७८"},
+		{"en-IN", FormatOptions{}, "USD 1234567.00", "US$12,34,567.00"},
+		{"en-GB", FormatOptions{}, "INR 123456.78", "₹123,456.78"},
+		// Right-to-left locales put the symbol after the amount and start with a directionality mark.
+		{"ar", FormatOptions{}, "SAR 1234.50", "\u061c١٬٢٣٤٫٥٠\u00a0ر.س.\u200f"},
+		{"ar", FormatOptions{}, "SAR -1234.50", "\u061c-١٬٢٣٤٫٥٠\u00a0ر.س.\u200f"},
+		{"ar", FormatOptions{Code: true}, "KWD 1234.500", "\u061c١٬٢٣٤٫٥٠٠\u00a0KWD"},
+		{"ar", FormatOptions{Position: SymbolBefore}, "AED 5.00", "\u061cد.إ.\u200f٥٫٠٠"},
+		{"ar-SA", FormatOptions{}, "SAR 1234.50", "\u061c1,234.50\u00a0ر.س.\u200f"},
+		{"ar-SA", FormatOptions{}, "SAR -1234.50", "\u061c\u200e-1,234.50\u00a0ر.س.\u200f"},
+		{"he", FormatOptions{}, "ILS -1234.50", "\u200f\u200e-1,234.50\u00a0₪"},
+		{"fa", FormatOptions{}, "IRR 1234", "\u061c۱٬۲۳۴٫۰۰\u00a0ریال"},
+		// Digits.
+		{"ar-SA", FormatOptions{Digits: NativeDigits}, "SAR -1234.50", "\u061c-١٬٢٣٤٫٥٠\u00a0ر.س.\u200f"},
+		{"ar", FormatOptions{Digits: LatinDigits}, "AED 1234.50", "\u061c1,234.50\u00a0د.إ.\u200f"},
+		{"ar", FormatOptions{Digits: LocaleDigits}, "AED 1234.50", "\u061c١٬٢٣٤٫٥٠\u00a0د.إ.\u200f"},
+		{"ur", FormatOptions{Digits: NativeDigits}, "PKR 1234.00", "\u061c۱٬۲۳۴٫۰۰\u00a0Rs"},
+		{"en-GB", FormatOptions{Digits: NativeDigits}, "GBP 1234.56", "£1,234.56"},
+		// Marks.
+		{"ar", FormatOptions{Digits: LatinDigits, NoMarks: true}, "SAR -1234.50", "-1,234.50\u00a0ر.س."},
+		{"he", FormatOptions{NoMarks: true}, "ILS -1234.50", "-1,234.50\u00a0₪"},
+	}
+	for _, c := range cases {
+		m, err := Parse(c.m)
+		if err != nil {
+			t.Fatalf("error received from Parse(%q), none expected: %v", c.m, err)
+		}
+		f := NewFormatter(language.MustParse(c.tag), c.opts)
+		if got := f.Format(m); got != c.want {
+			t.Errorf("formatting %v for %s with %+v: wanted %q, got %q", m, c.tag, c.opts, c.want, got)
+		}
+	}
+}
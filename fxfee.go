@@ -0,0 +1,64 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// FXFee discloses the cost of a currency conversion, as required by e.g. the EU cross-border
+// payments regulation, which mandates showing the markup over a reference rate.
+type FXFee struct {
+	// Amount is the amount converted, in the customer's currency.
+	Amount Money
+	// Converted is Amount converted at the applied rate.
+	Converted Money
+	// AtMid is Amount converted at the mid-market rate.
+	AtMid Money
+	// Markup is the applied rate's markup over the mid-market rate, exactly: how much more of the
+	// customer's currency each unit of the other currency costs. Its String method gives it as a
+	// percentage for display.
+	Markup Proportion
+	// Fee is the cost of the markup in the customer's currency.
+	Fee Money
+	// ConvertedFee is the cost of the markup in the other currency, i.e. AtMid less Converted.
+	ConvertedFee Money
+}
+
+// DiscloseFXFee returns the disclosure for converting x into the currency to at the applied rate,
+// given the mid-market rate. Rates are the amount of to in major units that one major unit of
+// x's currency buys, as for RateProvider. Converted amounts and the fees are rounded using mode.
+// The fee is negative if the applied rate is better for the customer than the mid-market rate.
+// It returns an error if to is not well formed or not recognised, if either rate isn't positive,
+// or if a result can't be represented.
+func DiscloseFXFee(x Money, to string, mid, applied *big.Rat, mode RoundingMode) (FXFee, error) {
+	c, err := currency.ParseISO(to)
+	if err != nil {
+		return FXFee{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if mid == nil || mid.Sign() <= 0 || applied == nil || applied.Sign() <= 0 {
+		return FXFee{}, fmt.Errorf("invalid rates from %s to %s: mid %v, applied %v", x.Currency(), to, mid, applied)
+	}
+	f := FXFee{Amount: x}
+	if f.Converted, err = fromRat(c, new(big.Rat).Mul(x.Rat(), applied), mode); err != nil {
+		return FXFee{}, err
+	}
+	if f.AtMid, err = fromRat(c, new(big.Rat).Mul(x.Rat(), mid), mode); err != nil {
+		return FXFee{}, err
+	}
+	// The fee in the other currency is the difference of the rounded amounts, so that it reconciles
+	// with them, rather than x × (mid - applied) rounded separately.
+	if f.ConvertedFee, err = f.AtMid.Sub(f.Converted); err != nil {
+		return FXFee{}, err
+	}
+	// The fee is worth x × (1 - applied/mid) in the customer's currency at the mid-market rate.
+	share := new(big.Rat).Quo(applied, mid)
+	share.Sub(big.NewRat(1, 1), share)
+	if f.Fee, err = fromRat(x.c, share.Mul(share, x.Rat()), mode); err != nil {
+		return FXFee{}, err
+	}
+	markup := new(big.Rat).Quo(mid, applied)
+	f.Markup = NewProportion(markup.Sub(markup, big.NewRat(1, 1)))
+	return f, nil
+}
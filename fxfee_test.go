@@ -0,0 +1,84 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanDiscloseFXFee(t *testing.T) {
+	eur := func(amt string) Money {
+		x, _ := New("EUR", amt)
+		return x
+	}
+	var cases = []struct {
+		x            Money
+		to           string
+		mid, applied *big.Rat
+		converted    Money
+		atMid        Money
+		markup       *big.Rat
+		fee          Money
+		convertedFee Money
+	}{
+		// 100 EUR to GBP at 0.85 mid, 0.83 applied: 2.41% markup, £2 less, worth €2.35.
+		{eur("100.00"), "GBP", big.NewRat(85, 100), big.NewRat(83, 100), gbp("83.00"), gbp("85.00"), big.NewRat(2, 83), eur("2.35"), gbp("2.00")},
+		{eur("100.00"), "GBP", big.NewRat(85, 100), big.NewRat(85, 100), gbp("85.00"), gbp("85.00"), new(big.Rat), eur("0.00"), gbp("0.00")},
+		{eur("100.00"), "GBP", big.NewRat(85, 100), big.NewRat(86, 100), gbp("86.00"), gbp("85.00"), big.NewRat(-1, 86), eur("-1.18"), gbp("-1.00")},
+		{gbp("10.00"), "JPY", big.NewRat(190, 1), big.NewRat(185, 1), MustNew("JPY", "1850"), MustNew("JPY", "1900"), big.NewRat(1, 37), gbp("0.26"), MustNew("JPY", "50")},
+	}
+	for i, c := range cases {
+		got, err := DiscloseFXFee(c.x, c.to, c.mid, c.applied, HalfUp)
+		if err != nil {
+			t.Errorf("%d: error received from DiscloseFXFee, none expected %v", i, err)
+			continue
+		}
+		if got.Amount != c.x || got.Converted != c.converted || got.AtMid != c.atMid || got.Fee != c.fee || got.ConvertedFee != c.convertedFee || got.Markup.Rat().Cmp(c.markup) != 0 {
+			t.Errorf("%d: wanted %v %v %v %v %v %v, got %+v", i, c.x, c.converted, c.atMid, NewProportion(c.markup), c.fee, c.convertedFee, got)
+		}
+	}
+}
+
+func TestCanRejectBadFXFee(t *testing.T) {
+	one := big.NewRat(1, 1)
+	var cases = []struct {
+		to           string
+		mid, applied *big.Rat
+	}{
+		{"FOO", one, one},
+		{"EUR", nil, one},
+		{"EUR", one, nil},
+		{"EUR", new(big.Rat), one},
+		{"EUR", one, big.NewRat(-1, 1)},
+	}
+	for _, c := range cases {
+		if got, err := DiscloseFXFee(gbp("1.00"), c.to, c.mid, c.applied, HalfUp); err == nil {
+			t.Errorf("error expected from DiscloseFXFee(%s, %v, %v), none received, got %+v", c.to, c.mid, c.applied, got)
+		}
+	}
+}
+
+func TestCanReconcileFXFee(t *testing.T) {
+	// GBP 1.00 is EUR 1.155 at mid and EUR 1.145 applied, which round half-even to EUR 1.16 and 1.14,
+	// so the converted fee must be EUR 0.02 rather than the EUR 0.01 that rounding 1.00 × 0.01 gives.
+	got, err := DiscloseFXFee(gbp("1.00"), "EUR", big.NewRat(1155, 1000), big.NewRat(1145, 1000), HalfEven)
+	if err != nil {
+		t.Fatalf("DiscloseFXFee: unexpected error %v", err)
+	}
+	want := MustNew("EUR", "0.02")
+	if got.ConvertedFee != want {
+		t.Errorf("wanted converted fee %v, got %v", want, got.ConvertedFee)
+	}
+	if diff, _ := got.AtMid.Sub(got.Converted); diff != got.ConvertedFee {
+		t.Errorf("converted fee %v doesn't reconcile with %v - %v", got.ConvertedFee, got.AtMid, got.Converted)
+	}
+}
+
+func TestCanDisplayFXMarkup(t *testing.T) {
+	got, err := DiscloseFXFee(MustNew("EUR", "100.00"), "GBP", big.NewRat(85, 100), big.NewRat(80, 100), HalfUp)
+	if err != nil {
+		t.Fatalf("DiscloseFXFee: unexpected error %v", err)
+	}
+	if s := got.Markup.String(); s != "6.25%" {
+		t.Errorf("wanted markup 6.25%%, got %s", s)
+	}
+}
@@ -0,0 +1,53 @@
+//go:build ignore
+
+// This program generates constructors.go. Invoke it as
+//
+//	go generate
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+)
+
+// Currencies with a predefined unit in golang.org/x/text/currency.
+var currencies = []string{
+	"AUD", "BRL", "CAD", "CHF", "CNY", "DKK", "EUR", "GBP", "HKD",
+	"IDR", "INR", "JPY", "KRW", "MXN", "NOK", "NZD", "PLN", "RUB",
+	"SAR", "SEK", "THB", "TRY", "TWD", "USD", "ZAR",
+	// ISO 4217 codes for precious metals, for testing, and for transactions with no currency.
+	"XAG", "XAU", "XPD", "XPT", "XTS", "XXX",
+}
+
+// examples holds example amounts for currencies that don't have two decimal places.
+var examples = map[string]string{
+	"JPY": "12345", "KRW": "12345",
+	"XAG": "1.2345", "XAU": "1.2345", "XPD": "1.2345", "XPT": "1.2345",
+}
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_constructors.go; DO NOT EDIT.\n\n")
+	b.WriteString("package dough\n\n")
+	b.WriteString("import \"golang.org/x/text/currency\"\n")
+	for _, c := range currencies {
+		ex, ok := examples[c]
+		if !ok {
+			ex = "123.45"
+		}
+		b.WriteString("\n// " + c + " returns a new " + c + " Money for the given amount, e.g. \"" + ex + "\".\n")
+		b.WriteString("// It returns an error if amt cannot be parsed.\n")
+		b.WriteString("func " + c + "(amt string) (Money, error) {\n")
+		b.WriteString("\treturn newMoney(currency." + c + ", amt)\n")
+		b.WriteString("}\n")
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("constructors.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
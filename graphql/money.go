@@ -0,0 +1,49 @@
+// Package graphql provides a Money scalar for GraphQL servers generated with gqlgen.
+//
+// To use it, declare the scalar in your schema:
+//
+//	scalar Money
+//
+// and bind it in gqlgen.yml:
+//
+//	models:
+//	  Money:
+//	    model: github.com/itsoneiota/dough-go/graphql.Money
+package graphql
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Money is a dough.Money that implements gqlgen's Marshaler and Unmarshaler interfaces.
+// It is represented as a string holding the currency code and amount, e.g. "GBP 123.45".
+type Money struct {
+	dough.Money
+}
+
+// MarshalGQL writes the Money as a GraphQL string.
+func (m Money) MarshalGQL(w io.Writer) {
+	b := make([]byte, 0, 32)
+	b = append(b, '"')
+	b = m.AppendString(b)
+	b = append(b, '"')
+	w.Write(b)
+}
+
+// UnmarshalGQL sets the Money from a GraphQL input value, e.g. "GBP 123.45".
+// It returns an error if v is not a string, or cannot be parsed.
+func (m *Money) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Money must be a string, got %T", v)
+	}
+	x, err := dough.Parse(s)
+	if err != nil {
+		return err
+	}
+	m.Money = x
+	return nil
+}
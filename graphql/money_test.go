@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanMarshalGQL(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "123.45", `"GBP 123.45"`},
+		{"EUR", "-0.01", `"EUR -0.01"`},
+	}
+	for _, c := range cases {
+		x, _ := dough.New(c.cur, c.amt)
+		var b bytes.Buffer
+		Money{x}.MarshalGQL(&b)
+		if b.String() != c.want {
+			t.Errorf("wanted %s, got %s", c.want, b.String())
+		}
+	}
+}
+
+func TestCanUnmarshalGQL(t *testing.T) {
+	var cases = []struct {
+		v   interface{}
+		cur string
+		amt string
+	}{
+		{"GBP 123.45", "GBP", "123.45"},
+		{" EUR  -0.01 ", "EUR", "-0.01"},
+	}
+	for _, c := range cases {
+		var m Money
+		if err := m.UnmarshalGQL(c.v); err != nil {
+			t.Errorf("error received from UnmarshalGQL(%v), none expected %v", c.v, err)
+		}
+		if m.Currency() != c.cur || m.Amount() != c.amt {
+			t.Errorf("wanted %s %s, got %v", c.cur, c.amt, m.Money)
+		}
+	}
+}
+
+func TestCanRejectBadGQL(t *testing.T) {
+	var cases = []struct {
+		v interface{}
+	}{
+		{123.45},
+		{nil},
+		{"GBP"},
+		{"123.45"},
+		{"GBP 1.2.3"},
+		{"FOO 123.45"},
+		{"GBP 123.45 extra"},
+	}
+	for _, c := range cases {
+		var m Money
+		if err := m.UnmarshalGQL(c.v); err == nil {
+			t.Errorf("error expected from UnmarshalGQL(%v), none received", c.v)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package dough
+
+import "hash/fnv"
+
+// Hash returns a stable 64-bit fingerprint of the Money, suitable for deduplication and sharding.
+// Equal values always have equal hashes. The hash is the FNV-1a hash of the currency code
+// followed by the amount in minor units as a big-endian int64, and will not change between releases.
+// (It changed once, for currencies without two decimal places, when Money adopted ISO 4217 minor units;
+// see the README.)
+func (x Money) Hash() uint64 {
+	var b [11]byte
+	copy(b[:3], x.Currency())
+	a := uint64(x.a)
+	for i := 0; i < 8; i++ {
+		b[10-i] = byte(a >> (8 * i))
+	}
+	h := fnv.New64a()
+	h.Write(b[:])
+	return h.Sum64()
+}
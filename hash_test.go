@@ -0,0 +1,52 @@
+package dough
+
+import "testing"
+
+func TestCanUseAsMapKey(t *testing.T) {
+	a, _ := New("GBP", "1.00")
+	b, _ := New("GBP", "1.00")
+	c, _ := New("EUR", "1.00")
+	z, _ := New("GBP", "-0.00")
+	zz, _ := New("GBP", "0.00")
+	m := map[Money]int{}
+	m[a]++
+	m[b]++
+	m[c]++
+	m[z]++
+	m[zz]++
+	if len(m) != 3 || m[a] != 2 || m[z] != 2 {
+		t.Errorf("wanted 3 distinct keys with counts 2, 1, 2, got %v", m)
+	}
+}
+
+func TestCanHash(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want uint64
+	}{
+		// Pinned values. These must never change.
+		{"GBP", "0.00", 0x6dead114287ffcd4},
+		{"GBP", "1.00", 0x6deaad14287fbfa8},
+		{"EUR", "1.00", 0x674ec6ef3badc73b},
+		{"GBP", "-1.00", 0x4a1bd7c58637ff85},
+		// Currencies without two decimal places are hashed in their own minor units.
+		{"JPY", "0", 0x73bff78ed8a6660a},
+		{"JPY", "100", 0x73bfd38ed8a628de},
+		{"JPY", "-100", 0x8ef328313cedb5af},
+		{"KWD", "1.234", 0xe55556ee37ecbf07},
+		{"KWD", "-1.234", 0x50da93ef5f4437d2},
+	}
+	seen := map[uint64]bool{}
+	for _, c := range cases {
+		sut, _ := New(c.cur, c.amt)
+		got := sut.Hash()
+		if got != c.want {
+			t.Errorf("hash of %s %s: wanted %#x, got %#x", c.cur, c.amt, c.want, got)
+		}
+		if seen[got] {
+			t.Errorf("hash of %s %s collides", c.cur, c.amt)
+		}
+		seen[got] = true
+	}
+}
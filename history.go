@@ -0,0 +1,155 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNoRateOnDate is returned, wrapped, by a RateHistory when it has no rate for a date and its
+// MissingRatePolicy can't supply one.
+var ErrNoRateOnDate = errors.New("no rate on date")
+
+// MissingRatePolicy determines how a RateHistory resolves a rate for a date it has no rate for,
+// e.g. a weekend, when the ECB publishes no rates.
+type MissingRatePolicy int
+
+const (
+	// RateStrict returns an error wrapping ErrNoRateOnDate.
+	RateStrict MissingRatePolicy = iota
+	// RatePrevious uses the rate from the most recent earlier date that has one,
+	// i.e. the previous business day of the source.
+	RatePrevious
+	// RateInterpolate interpolates linearly, by day, between the rates from the nearest earlier
+	// and later dates that have one. It returns an error wrapping ErrNoRateOnDate if either is missing.
+	RateInterpolate
+	// RateEffective uses the rate from the EffectiveDate for both currencies, i.e. the latest
+	// business day in both markets, according to their registered calendars. It returns an error
+	// wrapping ErrNoRateOnDate if there is no rate for that date.
+	RateEffective
+)
+
+// RateHistory holds a set of rates for each of a number of dates, for historical lookups.
+// Only the date of each time.Time is used; the time and location are ignored.
+// The zero value is an empty RateHistory. A RateHistory is safe for concurrent use.
+type RateHistory struct {
+	mu   sync.RWMutex
+	days []datedRates
+}
+
+type datedRates struct {
+	date  time.Time
+	rates RateSnapshot
+}
+
+// day returns the date of t, as midnight UTC, so that dates can be compared and subtracted.
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Set sets the rates for date, replacing any already set for it.
+// If rates has more than one rate for a pair of currencies, the last is used.
+func (h *RateHistory) Set(date time.Time, rates []Rate) {
+	m := make(map[ratePair]*big.Rat, len(rates))
+	for _, r := range rates {
+		m[ratePair{r.from, r.to}] = r.r
+	}
+	d := datedRates{day(date), RateSnapshot{m}}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	i := h.search(d.date)
+	if i < len(h.days) && h.days[i].date.Equal(d.date) {
+		h.days[i] = d
+		return
+	}
+	h.days = append(h.days, datedRates{})
+	copy(h.days[i+1:], h.days[i:])
+	h.days[i] = d
+}
+
+// search returns the index of the first of h.days not before date.
+func (h *RateHistory) search(date time.Time) int {
+	return sort.Search(len(h.days), func(i int) bool {
+		return !h.days[i].date.Before(date)
+	})
+}
+
+// RateOn returns the rate from one currency to the other on date, resolved using policy if there
+// is no rate for that date. A date whose rates don't include the pair, or its inverse, counts
+// as missing.
+// It returns an error if either currency is not well formed or not recognised, or one wrapping
+// ErrNoRateOnDate if policy can't supply a rate.
+func (h *RateHistory) RateOn(date time.Time, from, to string, policy MissingRatePolicy) (*big.Rat, error) {
+	if _, err := NewRate(from, to, big.NewRat(1, 1)); err != nil {
+		return nil, err
+	}
+	date = day(date)
+	if policy == RateEffective {
+		d, err := EffectiveDate(date, from, to)
+		if err != nil {
+			return nil, err
+		}
+		date = d
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	i := h.search(date)
+	if i < len(h.days) && h.days[i].date.Equal(date) {
+		if r, err := h.days[i].rates.Rate(from, to); err == nil {
+			return r, nil
+		}
+	}
+	missing := fmt.Errorf("%w: %s to %s on %s", ErrNoRateOnDate, from, to, date.Format("2006-01-02"))
+	switch policy {
+	case RatePrevious:
+		if _, r := h.previous(i, from, to); r != nil {
+			return r, nil
+		}
+	case RateInterpolate:
+		d0, r0 := h.previous(i, from, to)
+		d1, r1 := h.next(i, date, from, to)
+		if r0 == nil || r1 == nil {
+			return nil, missing
+		}
+		// r0 + (r1 - r0) * (date - d0) / (d1 - d0)
+		f := big.NewRat(int64(date.Sub(d0)/(24*time.Hour)), int64(d1.Sub(d0)/(24*time.Hour)))
+		r := new(big.Rat).Sub(r1, r0)
+		return r.Add(r0, r.Mul(r, f)), nil
+	}
+	return nil, missing
+}
+
+// previous returns the most recent rate from one currency to the other before h.days[i], and its date.
+func (h *RateHistory) previous(i int, from, to string) (time.Time, *big.Rat) {
+	for i--; i >= 0; i-- {
+		if r, err := h.days[i].rates.Rate(from, to); err == nil {
+			return h.days[i].date, r
+		}
+	}
+	return time.Time{}, nil
+}
+
+// next returns the earliest rate from one currency to the other after date, starting at h.days[i], and its date.
+func (h *RateHistory) next(i int, date time.Time, from, to string) (time.Time, *big.Rat) {
+	for ; i < len(h.days); i++ {
+		if !h.days[i].date.After(date) {
+			continue
+		}
+		if r, err := h.days[i].rates.Rate(from, to); err == nil {
+			return h.days[i].date, r
+		}
+	}
+	return time.Time{}, nil
+}
+
+// On returns a RateProvider of the rates on date, resolved using policy as described at RateOn,
+// e.g. to Convert an invoice amount at the rate on its invoice date.
+func (h *RateHistory) On(date time.Time, policy MissingRatePolicy) RateProvider {
+	return RateFunc(func(from, to string) (*big.Rat, error) {
+		return h.RateOn(date, from, to, policy)
+	})
+}
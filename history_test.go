@@ -0,0 +1,92 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testHistory() *RateHistory {
+	rate := func(from, to, s string) Rate {
+		r, _ := ParseRate(from, to, s)
+		return r
+	}
+	h := &RateHistory{}
+	// Set out of order, to check dates are kept sorted.
+	h.Set(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), []Rate{rate("GBP", "USD", "1.30")})
+	h.Set(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), []Rate{rate("GBP", "USD", "1.27")})
+	h.Set(time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), []Rate{rate("EUR", "USD", "1.09")})
+	return h
+}
+
+func TestCanLookUpRateHistory(t *testing.T) {
+	h := testHistory()
+	var cases = []struct {
+		date     string
+		from, to string
+		policy   MissingRatePolicy
+		want     string
+	}{
+		{"2024-01-05", "GBP", "USD", RateStrict, "127/100"},
+		{"2024-01-05", "USD", "GBP", RateStrict, "100/127"},
+		{"2024-01-08", "GBP", "USD", RateInterpolate, "13/10"},
+		{"2024-01-06", "GBP", "USD", RatePrevious, "127/100"},
+		{"2024-01-07", "GBP", "USD", RatePrevious, "127/100"},
+		{"2024-01-06", "GBP", "USD", RateInterpolate, "32/25"},
+		{"2024-01-07", "GBP", "USD", RateInterpolate, "129/100"},
+		// 2024-01-09 has rates, but not for GBP/USD.
+		{"2024-01-09", "GBP", "USD", RatePrevious, "13/10"},
+		{"2024-01-31", "GBP", "USD", RatePrevious, "13/10"},
+		{"2024-01-31", "EUR", "USD", RatePrevious, "109/100"},
+	}
+	for _, c := range cases {
+		date, _ := time.Parse("2006-01-02", c.date)
+		if got, err := h.RateOn(date, c.from, c.to, c.policy); err != nil || got.RatString() != c.want {
+			t.Errorf("RateOn(%s, %s, %s, %d): wanted %s, got %v (%v)", c.date, c.from, c.to, c.policy, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectMissingHistoricalRate(t *testing.T) {
+	h := testHistory()
+	var cases = []struct {
+		date     string
+		from, to string
+		policy   MissingRatePolicy
+	}{
+		{"2024-01-06", "GBP", "USD", RateStrict},
+		{"2024-01-09", "GBP", "USD", RateStrict},
+		{"2024-01-04", "GBP", "USD", RatePrevious},
+		{"2024-01-04", "GBP", "USD", RateInterpolate},
+		{"2024-01-09", "GBP", "USD", RateInterpolate},
+		{"2024-01-06", "GBP", "JPY", RatePrevious},
+	}
+	for _, c := range cases {
+		date, _ := time.Parse("2006-01-02", c.date)
+		if got, err := h.RateOn(date, c.from, c.to, c.policy); !errors.Is(err, ErrNoRateOnDate) {
+			t.Errorf("RateOn(%s, %s, %s, %d): wanted ErrNoRateOnDate, got %v (%v)", c.date, c.from, c.to, c.policy, got, err)
+		}
+	}
+	if _, err := h.RateOn(time.Now(), "FOO", "USD", RatePrevious); err == nil || errors.Is(err, ErrNoRateOnDate) {
+		t.Errorf("wanted currency error from RateOn with bad currency, got %v", err)
+	}
+	if _, err := (&RateHistory{}).RateOn(time.Now(), "GBP", "USD", RatePrevious); !errors.Is(err, ErrNoRateOnDate) {
+		t.Errorf("wanted ErrNoRateOnDate from empty RateHistory, got %v", err)
+	}
+}
+
+func TestCanConvertOnDate(t *testing.T) {
+	h := testHistory()
+	// Only the date matters, not the time or location.
+	sunday := time.Date(2024, 1, 7, 23, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+	got, err := Convert(MustNew("GBP", "100.00"), "USD", h.On(sunday, RatePrevious), HalfUp)
+	if err != nil || got.String() != "USD 127.00" {
+		t.Errorf("wanted USD 127.00, got %v (%v)", got, err)
+	}
+	r, _ := ParseRate("GBP", "USD", "1.28")
+	h.Set(sunday, []Rate{r})
+	got, err = Convert(MustNew("GBP", "100.00"), "USD", h.On(sunday, RateStrict), HalfUp)
+	if err != nil || got.String() != "USD 128.00" {
+		t.Errorf("wanted USD 128.00 after Set, got %v (%v)", got, err)
+	}
+}
@@ -0,0 +1,277 @@
+// Package httprates fetches exchange rates from common HTTP APIs: Open Exchange Rates, Fixer and
+// exchangerate.host.
+//
+// Each Client validates responses before use: the API must report success, the base currency must
+// be the one requested, and every rate must be a positive decimal. Rates are parsed from the JSON
+// text exactly, never via float64. Rates for codes that aren't ISO 4217 currencies, e.g. BTC,
+// are ignored.
+package httprates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// api describes the request and response format of a rate API.
+type api interface {
+	// url returns the URL of the latest rates against base.
+	url(baseURL, key, base string) string
+	// parse returns the base currency and rates from a response body.
+	parse(body []byte) (base string, rates map[string]json.Number, err error)
+}
+
+// Client fetches the latest rates from an HTTP API. Its fields may be changed before first use.
+// A Client is a dough.RateProvider, fetching the rates against from for every call to Rate,
+// so it is usually better to Refresh a dough.RateTable from it periodically.
+type Client struct {
+	// HTTP is the client used for requests. If it is nil, http.DefaultClient is used.
+	HTTP *http.Client
+	// BaseURL is the root of the API, e.g. "https://openexchangerates.org/api".
+	BaseURL string
+	// Key is the API key or app ID.
+	Key string
+	// Retries is the number of times a request is retried after a network error, a 429 or a 5xx response.
+	Retries int
+	// Backoff is the wait before the first retry. It doubles with each retry.
+	Backoff time.Duration
+	api     api
+}
+
+// OpenExchangeRates returns a Client for https://openexchangerates.org, authenticated by appID.
+func OpenExchangeRates(appID string) *Client {
+	return &Client{BaseURL: "https://openexchangerates.org/api", Key: appID, Retries: 2, Backoff: time.Second, api: oxr{}}
+}
+
+// Fixer returns a Client for https://fixer.io, authenticated by accessKey.
+func Fixer(accessKey string) *Client {
+	return &Client{BaseURL: "https://data.fixer.io/api", Key: accessKey, Retries: 2, Backoff: time.Second, api: fixer{}}
+}
+
+// ExchangeRateHost returns a Client for https://exchangerate.host, authenticated by accessKey.
+func ExchangeRateHost(accessKey string) *Client {
+	return &Client{BaseURL: "https://api.exchangerate.host", Key: accessKey, Retries: 2, Backoff: time.Second, api: erh{}}
+}
+
+// Latest returns the latest rates from base to every other currency the API quotes.
+// It returns an error if the request fails after any retries, or the response isn't valid.
+func (c *Client) Latest(ctx context.Context, base string) ([]dough.Rate, error) {
+	body, err := c.get(ctx, c.api.url(c.BaseURL, c.Key, base))
+	if err != nil {
+		return nil, err
+	}
+	got, quotes, err := c.api.parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(got, base) {
+		return nil, fmt.Errorf("rates are against %s, requested %s", got, base)
+	}
+	rates := make([]dough.Rate, 0, len(quotes))
+	for to, n := range quotes {
+		r, ok := new(big.Rat).SetString(string(n))
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate from %s to %s: %s", base, to, n)
+		}
+		rate, err := dough.NewRate(base, to, r)
+		if err != nil {
+			continue
+		}
+		rates = append(rates, rate)
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("no rates against %s", base)
+	}
+	return rates, nil
+}
+
+// Refresh replaces the rates in table with the latest rates against base, leaving the table
+// unchanged if they can't be fetched.
+func (c *Client) Refresh(ctx context.Context, table *dough.RateTable, base string) error {
+	rates, err := c.Latest(ctx, base)
+	if err != nil {
+		return err
+	}
+	table.Replace(rates)
+	return nil
+}
+
+// Rate implements dough.RateProvider, fetching the latest rates against from.
+func (c *Client) Rate(from, to string) (*big.Rat, error) {
+	rates, err := c.Latest(context.Background(), from)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rates {
+		if r.To() == strings.ToUpper(to) {
+			return r.Rat(), nil
+		}
+	}
+	return nil, fmt.Errorf("no rate from %s to %s", from, to)
+}
+
+// errRetry marks a failure worth retrying.
+var errRetry = errors.New("retryable")
+
+// get fetches u, retrying as configured.
+func (c *Client) get(ctx context.Context, u string) ([]byte, error) {
+	hc := c.HTTP
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	wait := c.Backoff
+	for attempt := 0; ; attempt++ {
+		body, err := c.fetch(ctx, hc, u)
+		if err == nil || !errors.Is(err, errRetry) || attempt >= c.Retries {
+			return body, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+func (c *Client) fetch(ctx context.Context, hc *http.Client, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, withoutURL(err)
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, withoutURL(err)
+		}
+		return nil, fmt.Errorf("%w: %v", errRetry, withoutURL(err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errRetry, err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, fmt.Errorf("%w: %s", errRetry, resp.Status)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("rate request failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// withoutURL returns err without the URL of a *url.Error, which would expose the API key
+// in its query string to anything that logs the error.
+func withoutURL(err error) error {
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		return fmt.Errorf("rate request failed: %s: %w", uerr.Op, uerr.Err)
+	}
+	return err
+}
+
+// oxr is the Open Exchange Rates API.
+type oxr struct{}
+
+func (oxr) url(baseURL, key, base string) string {
+	return baseURL + "/latest.json?" + url.Values{"app_id": {key}, "base": {base}}.Encode()
+}
+
+func (oxr) parse(body []byte) (string, map[string]json.Number, error) {
+	var r struct {
+		Error       bool                   `json:"error"`
+		Description string                 `json:"description"`
+		Base        string                 `json:"base"`
+		Rates       map[string]json.Number `json:"rates"`
+	}
+	if err := decode(body, &r); err != nil {
+		return "", nil, err
+	}
+	if r.Error {
+		return "", nil, fmt.Errorf("rate request failed: %s", r.Description)
+	}
+	return r.Base, r.Rates, nil
+}
+
+// fixer is the Fixer API.
+type fixer struct{}
+
+func (fixer) url(baseURL, key, base string) string {
+	return baseURL + "/latest?" + url.Values{"access_key": {key}, "base": {base}}.Encode()
+}
+
+func (fixer) parse(body []byte) (string, map[string]json.Number, error) {
+	var r struct {
+		apiError
+		Base  string                 `json:"base"`
+		Rates map[string]json.Number `json:"rates"`
+	}
+	if err := decode(body, &r); err != nil {
+		return "", nil, err
+	}
+	if err := r.check(); err != nil {
+		return "", nil, err
+	}
+	return r.Base, r.Rates, nil
+}
+
+// erh is the exchangerate.host API, which keys quotes by currency pair, e.g. "USDGBP".
+type erh struct{}
+
+func (erh) url(baseURL, key, base string) string {
+	return baseURL + "/live?" + url.Values{"access_key": {key}, "source": {base}}.Encode()
+}
+
+func (erh) parse(body []byte) (string, map[string]json.Number, error) {
+	var r struct {
+		apiError
+		Source string                 `json:"source"`
+		Quotes map[string]json.Number `json:"quotes"`
+	}
+	if err := decode(body, &r); err != nil {
+		return "", nil, err
+	}
+	if err := r.check(); err != nil {
+		return "", nil, err
+	}
+	rates := make(map[string]json.Number, len(r.Quotes))
+	for pair, n := range r.Quotes {
+		if len(pair) != 6 || !strings.EqualFold(pair[:3], r.Source) {
+			return "", nil, fmt.Errorf("unexpected quote %s against %s", pair, r.Source)
+		}
+		rates[pair[3:]] = n
+	}
+	return r.Source, rates, nil
+}
+
+// apiError is the error format shared by Fixer and exchangerate.host.
+type apiError struct {
+	Success bool `json:"success"`
+	Error   struct {
+		Code int    `json:"code"`
+		Info string `json:"info"`
+	} `json:"error"`
+}
+
+func (e apiError) check() error {
+	if !e.Success {
+		return fmt.Errorf("rate request failed: %d %s", e.Error.Code, e.Error.Info)
+	}
+	return nil
+}
+
+func decode(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("couldn't parse rates: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package httprates
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func serve(t *testing.T, statuses []int, body string) (*httptest.Server, *int32) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if int(n) <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s, &calls
+}
+
+func TestCanFetchLatestRates(t *testing.T) {
+	var cases = []struct {
+		name   string
+		client func(key string) *Client
+		path   string
+		body   string
+	}{
+		{"oxr", OpenExchangeRates, "/latest.json", `{"base":"USD","rates":{"GBP":0.8,"JPY":150.25,"BTC":0.00001}}`},
+		{"fixer", Fixer, "/latest", `{"success":true,"base":"USD","rates":{"GBP":0.8,"JPY":150.25}}`},
+		{"exchangerate.host", ExchangeRateHost, "/live", `{"success":true,"source":"USD","quotes":{"USDGBP":0.8,"USDJPY":150.25}}`},
+	}
+	for _, c := range cases {
+		var path string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			w.Write([]byte(c.body))
+		}))
+		cl := c.client("key")
+		cl.BaseURL = s.URL
+		table := dough.NewRateTable()
+		if err := cl.Refresh(context.Background(), table, "USD"); err != nil {
+			t.Errorf("%s: error received from Refresh, none expected %v", c.name, err)
+		}
+		s.Close()
+		if path != c.path {
+			t.Errorf("%s: wanted request to %s, got %s", c.name, c.path, path)
+		}
+		if table.Snapshot().Len() != 2 {
+			t.Errorf("%s: wanted 2 rates, got %d", c.name, table.Snapshot().Len())
+		}
+		got, err := dough.Convert(dough.MustNew("USD", "10.00"), "JPY", table, dough.HalfUp)
+		if err != nil || got.String() != "JPY 1503" {
+			t.Errorf("%s: wanted JPY 1503, got %v (%v)", c.name, got, err)
+		}
+		if r, err := table.Rate("USD", "GBP"); err != nil || r.RatString() != "4/5" {
+			t.Errorf("%s: wanted exact USD/GBP 4/5, got %v (%v)", c.name, r, err)
+		}
+	}
+}
+
+func TestCanRetry(t *testing.T) {
+	s, calls := serve(t, []int{503, 429}, `{"base":"USD","rates":{"GBP":0.8}}`)
+	cl := OpenExchangeRates("key")
+	cl.BaseURL, cl.Backoff = s.URL, time.Millisecond
+	if r, err := cl.Rate("USD", "GBP"); err != nil || r.RatString() != "4/5" {
+		t.Errorf("wanted 4/5 after retries, got %v (%v)", r, err)
+	}
+	if *calls != 3 {
+		t.Errorf("wanted 3 calls, got %d", *calls)
+	}
+	s, calls = serve(t, []int{503, 503, 503}, `{"base":"USD","rates":{"GBP":0.8}}`)
+	cl.BaseURL = s.URL
+	if _, err := cl.Latest(context.Background(), "USD"); err == nil || *calls != 3 {
+		t.Errorf("wanted error after 3 calls, got %d calls (%v)", *calls, err)
+	}
+	s, calls = serve(t, []int{401}, `{"base":"USD","rates":{"GBP":0.8}}`)
+	cl.BaseURL = s.URL
+	if _, err := cl.Latest(context.Background(), "USD"); err == nil || *calls != 1 {
+		t.Errorf("wanted error without retry on 401, got %d calls (%v)", *calls, err)
+	}
+}
+
+func TestCanCancelRetries(t *testing.T) {
+	s, _ := serve(t, []int{503, 503, 503}, `{}`)
+	cl := OpenExchangeRates("key")
+	cl.BaseURL, cl.Backoff = s.URL, time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := cl.Latest(ctx, "USD"); err != context.DeadlineExceeded {
+		t.Errorf("wanted context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestCanKeepKeyOutOfErrors(t *testing.T) {
+	const key = "s3cr3t-key"
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+	var cases = []struct {
+		name    string
+		client  func(key string) *Client
+		baseURL string
+		timeout time.Duration
+	}{
+		{"oxr unreachable", OpenExchangeRates, closed.URL, time.Minute},
+		{"fixer unreachable", Fixer, closed.URL, time.Minute},
+		{"exchangerate.host unreachable", ExchangeRateHost, closed.URL, time.Minute},
+		{"bad base URL", OpenExchangeRates, "http://[::1", time.Minute},
+		{"timeout", Fixer, slow.URL, 10 * time.Millisecond},
+	}
+	for _, c := range cases {
+		cl := c.client(key)
+		cl.BaseURL, cl.Retries = c.baseURL, 0
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		_, err := cl.Latest(ctx, "USD")
+		cancel()
+		if err == nil {
+			t.Errorf("%s: error expected, none received", c.name)
+			continue
+		}
+		if strings.Contains(err.Error(), key) {
+			t.Errorf("%s: error contains API key: %v", c.name, err)
+		}
+		if c.name == "timeout" && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: wanted context.DeadlineExceeded, got %v", c.name, err)
+		}
+	}
+}
+
+func TestCanRejectBadResponses(t *testing.T) {
+	var cases = []struct {
+		name   string
+		client func(key string) *Client
+		body   string
+	}{
+		{"oxr error", OpenExchangeRates, `{"error":true,"status":401,"description":"Invalid App ID"}`},
+		{"oxr wrong base", OpenExchangeRates, `{"base":"EUR","rates":{"GBP":0.8}}`},
+		{"oxr negative rate", OpenExchangeRates, `{"base":"USD","rates":{"GBP":-0.8}}`},
+		{"oxr zero rate", OpenExchangeRates, `{"base":"USD","rates":{"GBP":0}}`},
+		{"oxr no rates", OpenExchangeRates, `{"base":"USD","rates":{}}`},
+		{"oxr not json", OpenExchangeRates, `<html>`},
+		{"fixer error", Fixer, `{"success":false,"error":{"code":101,"info":"No API Key"}}`},
+		{"exchangerate.host bad pair", ExchangeRateHost, `{"success":true,"source":"USD","quotes":{"EURGBP":0.8}}`},
+	}
+	for _, c := range cases {
+		s, _ := serve(t, nil, c.body)
+		cl := c.client("key")
+		cl.BaseURL = s.URL
+		if got, err := cl.Latest(context.Background(), "USD"); err == nil {
+			t.Errorf("%s: error expected from Latest, none received, got %v", c.name, got)
+		}
+	}
+	s, _ := serve(t, nil, `{"base":"USD","rates":{"GBP":0.8}}`)
+	cl := OpenExchangeRates("key")
+	cl.BaseURL = s.URL
+	if _, err := cl.Rate("USD", "JPY"); err == nil {
+		t.Errorf("error expected from Rate for missing currency, none received")
+	}
+}
@@ -0,0 +1,70 @@
+package dough
+
+import "fmt"
+
+// RemainderPlacement determines which installments absorb the remainder when an amount
+// doesn't divide evenly into installments.
+type RemainderPlacement int
+
+const (
+	// RemainderSpread spreads the remainder one minor unit at a time, from the first installment.
+	RemainderSpread RemainderPlacement = iota
+	// RemainderFirst adds the whole remainder to the first installment.
+	RemainderFirst
+	// RemainderLast adds the whole remainder to the last installment.
+	RemainderLast
+)
+
+// InstallmentOptions configures Installments.
+type InstallmentOptions struct {
+	// Remainder is where the rounding remainder is placed.
+	Remainder RemainderPlacement
+	// Minimum is the smallest allowed installment. If it is non-zero, the number of installments
+	// is reduced until every installment is at least Minimum.
+	Minimum Money
+}
+
+// Installments splits x into at most n installments that sum exactly to x,
+// placing any remainder as configured by opts.
+// It returns an error if x is negative, n is less than 1, opts.Minimum is negative or in a
+// different currency, or x is less than opts.Minimum.
+func (x Money) Installments(n int, opts InstallmentOptions) ([]Money, error) {
+	if x.a < 0 {
+		return nil, fmt.Errorf("can't split negative amount %v into installments", x)
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("number of installments must be at least 1, got %d", n)
+	}
+	if opts.Minimum.a != 0 {
+		if opts.Minimum.Currency() != x.Currency() {
+			return nil, fmt.Errorf("Can't apply %s minimum to %s installments", opts.Minimum.Currency(), x.Currency())
+		}
+		if opts.Minimum.a < 0 {
+			return nil, fmt.Errorf("minimum installment can't be negative, got %v", opts.Minimum)
+		}
+		if m := x.a / opts.Minimum.a; m < n {
+			n = m
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("%v is less than minimum installment of %s", x, opts.Minimum.Amount())
+		}
+	}
+	base, rem := x.a/n, x.a%n
+	res := make([]Money, n)
+	for i := range res {
+		res[i] = Money{x.c, base}
+	}
+	switch opts.Remainder {
+	case RemainderFirst:
+		res[0].a += rem
+	case RemainderLast:
+		res[n-1].a += rem
+	case RemainderSpread:
+		for i := 0; i < rem; i++ {
+			res[i].a++
+		}
+	default:
+		return nil, fmt.Errorf("unknown remainder placement %d", int(opts.Remainder))
+	}
+	return res, nil
+}
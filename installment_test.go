@@ -0,0 +1,64 @@
+package dough
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanSplitIntoInstallments(t *testing.T) {
+	var cases = []struct {
+		amt       string
+		n         int
+		remainder RemainderPlacement
+		minimum   string
+		want      []string
+	}{
+		{"100.00", 4, RemainderSpread, "0.00", []string{"25.00", "25.00", "25.00", "25.00"}},
+		{"100.00", 3, RemainderSpread, "0.00", []string{"33.34", "33.33", "33.33"}},
+		{"100.00", 3, RemainderFirst, "0.00", []string{"33.34", "33.33", "33.33"}},
+		{"100.00", 3, RemainderLast, "0.00", []string{"33.33", "33.33", "33.34"}},
+		{"0.05", 3, RemainderSpread, "0.00", []string{"0.02", "0.02", "0.01"}},
+		{"0.05", 3, RemainderFirst, "0.00", []string{"0.03", "0.01", "0.01"}},
+		{"0.05", 3, RemainderLast, "0.00", []string{"0.01", "0.01", "0.03"}},
+		{"100.00", 12, RemainderSpread, "10.00", []string{"10.00", "10.00", "10.00", "10.00", "10.00", "10.00", "10.00", "10.00", "10.00", "10.00"}},
+		{"100.00", 12, RemainderLast, "30.00", []string{"33.33", "33.33", "33.34"}},
+		{"100.00", 2, RemainderSpread, "30.00", []string{"50.00", "50.00"}},
+		{"0.00", 2, RemainderSpread, "0.00", []string{"0.00", "0.00"}},
+	}
+	for _, c := range cases {
+		x := gbp(c.amt)
+		got, err := x.Installments(c.n, InstallmentOptions{Remainder: c.remainder, Minimum: gbp(c.minimum)})
+		if err != nil {
+			t.Errorf("error received from %s.Installments(%d), none expected %v", c.amt, c.n, err)
+			continue
+		}
+		amts := []string{}
+		for _, m := range got {
+			amts = append(amts, m.Amount())
+		}
+		if !reflect.DeepEqual(amts, c.want) {
+			t.Errorf("%s.Installments(%d, %d, %s): wanted %v, got %v", c.amt, c.n, c.remainder, c.minimum, c.want, amts)
+		}
+	}
+}
+
+func TestCanRejectBadInstallments(t *testing.T) {
+	eur, _ := New("EUR", "1.00")
+	var cases = []struct {
+		amt  string
+		n    int
+		opts InstallmentOptions
+	}{
+		{"-1.00", 2, InstallmentOptions{}},
+		{"1.00", 0, InstallmentOptions{}},
+		{"1.00", 2, InstallmentOptions{Minimum: eur}},
+		{"1.00", 2, InstallmentOptions{Minimum: gbp("-0.01")}},
+		{"1.00", 2, InstallmentOptions{Minimum: gbp("1.01")}},
+		{"1.00", 2, InstallmentOptions{Remainder: RemainderPlacement(7)}},
+	}
+	for _, c := range cases {
+		if got, err := gbp(c.amt).Installments(c.n, c.opts); err == nil {
+			t.Errorf("error expected from %s.Installments(%d, %v), none received, got %v", c.amt, c.n, c.opts, got)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package invoice
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// CreditNote credits some or all of an invoice.
+type CreditNote struct {
+	// Quantities is the quantity credited on each line of the invoice, in the same order.
+	Quantities []int
+	// Lines is the amount credited on each line of the invoice, in the same order.
+	Lines []LineTotal
+	// Shipping is whether shipping is credited.
+	Shipping    bool
+	ShippingNet dough.Money
+	ShippingTax dough.Money
+	// Net is the sum of the lines' Net, plus ShippingNet.
+	Net dough.Money
+	// Tax is the sum of the lines' Tax, plus ShippingTax.
+	Tax dough.Money
+	// Total is Net plus Tax.
+	Total dough.Money
+}
+
+// Credit returns a credit note for quantities of the lines of inv, keyed by line index, and
+// optionally for shipping, given the credit notes already issued against it.
+//
+// Each line's amounts are credited in proportion to the quantity, so a credit note never
+// carries more discount or tax than the invoice allocated to the units it credits. Amounts are
+// rounded down cumulatively across credit notes, so the credit notes never exceed the invoice,
+// and crediting every unit credits exactly the invoiced amounts.
+//
+// It returns an error if the invoice can't be totalled, a line index is out of range, a quantity
+// is negative, or the quantities or shipping would be credited more than once in total.
+func (inv Invoice) Credit(quantities map[int]int, shipping bool, previous []CreditNote) (CreditNote, error) {
+	t, err := inv.Totals()
+	if err != nil {
+		return CreditNote{}, err
+	}
+	zero, _ := dough.FromMinorUnits(inv.Currency, 0)
+	credited := make([]int, len(inv.Lines))
+	shipped := false
+	for i, cn := range previous {
+		if len(cn.Quantities) != len(inv.Lines) {
+			return CreditNote{}, fmt.Errorf("credit note %d has %d lines, invoice has %d", i+1, len(cn.Quantities), len(inv.Lines))
+		}
+		for j, q := range cn.Quantities {
+			credited[j] += q
+		}
+		shipped = shipped || cn.Shipping
+	}
+	if shipping && shipped {
+		return CreditNote{}, fmt.Errorf("shipping already credited")
+	}
+	cn := CreditNote{
+		Quantities:  make([]int, len(inv.Lines)),
+		Lines:       make([]LineTotal, len(inv.Lines)),
+		Shipping:    shipping,
+		ShippingNet: zero,
+		ShippingTax: zero,
+	}
+	for i, q := range quantities {
+		if i < 0 || i >= len(inv.Lines) {
+			return CreditNote{}, fmt.Errorf("invoice has no line %d", i)
+		}
+		if q < 0 {
+			return CreditNote{}, fmt.Errorf("can't credit negative quantity %d of line %d", q, i)
+		}
+		if credited[i]+q > inv.Lines[i].Quantity {
+			return CreditNote{}, fmt.Errorf("can't credit %d of line %d: %d of %d already credited", q, i, credited[i], inv.Lines[i].Quantity)
+		}
+		cn.Quantities[i] = q
+	}
+	for i, lt := range t.Lines {
+		from, to, of := credited[i], credited[i]+cn.Quantities[i], inv.Lines[i].Quantity
+		l := &cn.Lines[i]
+		if l.Amount, err = portion(lt.Amount, from, to, of); err != nil {
+			return CreditNote{}, err
+		}
+		if l.Net, err = portion(lt.Net, from, to, of); err != nil {
+			return CreditNote{}, err
+		}
+		if l.Tax, err = portion(lt.Tax, from, to, of); err != nil {
+			return CreditNote{}, err
+		}
+		if l.Discount, err = l.Amount.Sub(l.Net); err != nil {
+			return CreditNote{}, err
+		}
+		if l.Total, err = l.Net.Add(l.Tax); err != nil {
+			return CreditNote{}, err
+		}
+	}
+	if shipping {
+		cn.ShippingNet, cn.ShippingTax = t.Shipping, t.ShippingTax
+	}
+	cn.Net, cn.Tax = cn.ShippingNet, cn.ShippingTax
+	for _, l := range cn.Lines {
+		if cn.Net, err = cn.Net.Add(l.Net); err != nil {
+			return CreditNote{}, err
+		}
+		if cn.Tax, err = cn.Tax.Add(l.Tax); err != nil {
+			return CreditNote{}, err
+		}
+	}
+	if cn.Total, err = cn.Net.Add(cn.Tax); err != nil {
+		return CreditNote{}, err
+	}
+	return cn, nil
+}
+
+// portion returns the part of x attributable to units from+1 to to of a total of units,
+// rounding the cumulative amounts down, so that consecutive portions sum exactly to x.
+func portion(x dough.Money, from, to, of int) (dough.Money, error) {
+	if of == 0 {
+		return dough.FromMinorUnits(x.Currency(), 0)
+	}
+	a := big.NewInt(x.MinorUnits())
+	cum := func(n int) *big.Int {
+		v := new(big.Int).Mul(a, big.NewInt(int64(n)))
+		return v.Quo(v, big.NewInt(int64(of)))
+	}
+	return dough.FromMinorUnits(x.Currency(), new(big.Int).Sub(cum(to), cum(from)).Int64())
+}
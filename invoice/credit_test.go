@@ -0,0 +1,104 @@
+package invoice
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func creditInvoice() Invoice {
+	return Invoice{
+		Currency: "GBP",
+		Lines: []Line{
+			{"Widget", 3, gbp("3.33"), percent(20)},
+			{"Book", 2, gbp("5.00"), percent(0)},
+		},
+		Discounts:       []Discount{{"Loyalty", gbp("1.00")}},
+		Shipping:        gbp("4.99"),
+		ShippingTaxRate: percent(20),
+		Rounding:        dough.HalfUp,
+	}
+}
+
+func TestCanCreditInvoice(t *testing.T) {
+	inv := creditInvoice()
+	cn, err := inv.Credit(map[int]int{0: 1}, false, nil)
+	if err != nil {
+		t.Fatalf("error received from Credit, none expected %v", err)
+	}
+	// Widget line: amount 9.99, discount 0.50, net 9.49, tax 1.90. One of three units is a third of each, rounded down.
+	l := cn.Lines[0]
+	if l.Amount != gbp("3.33") || l.Net != gbp("3.16") || l.Discount != gbp("0.17") || l.Tax != gbp("0.63") || l.Total != gbp("3.79") {
+		t.Errorf("wanted line 3.33 - 0.17 = 3.16 + 0.63 = 3.79, got %+v", l)
+	}
+	if cn.Lines[1].Total != gbp("0.00") || cn.Total != gbp("3.79") || cn.Quantities[0] != 1 || cn.Quantities[1] != 0 {
+		t.Errorf("wanted only line 0 credited for 3.79, got %+v", cn)
+	}
+}
+
+func TestCanCreditInvoiceInFullAcrossNotes(t *testing.T) {
+	inv := creditInvoice()
+	totals, _ := inv.Totals()
+	var notes []CreditNote
+	for _, req := range []struct {
+		q        map[int]int
+		shipping bool
+	}{
+		{map[int]int{0: 1}, false},
+		{map[int]int{0: 1, 1: 1}, true},
+		{map[int]int{0: 1, 1: 1}, false},
+	} {
+		cn, err := inv.Credit(req.q, req.shipping, notes)
+		if err != nil {
+			t.Fatalf("error received from Credit(%v), none expected %v", req.q, err)
+		}
+		notes = append(notes, cn)
+	}
+	sum := func(f func(CreditNote) dough.Money) dough.Money {
+		s := gbp("0.00")
+		for _, cn := range notes {
+			s, _ = s.Add(f(cn))
+		}
+		return s
+	}
+	if got := sum(func(cn CreditNote) dough.Money { return cn.Total }); got != totals.Total {
+		t.Errorf("credit notes total %v, wanted invoice total %v", got, totals.Total)
+	}
+	if got := sum(func(cn CreditNote) dough.Money { return cn.Tax }); got != totals.Tax {
+		t.Errorf("credit notes tax %v, wanted invoice tax %v", got, totals.Tax)
+	}
+	for i, lt := range totals.Lines {
+		if got := sum(func(cn CreditNote) dough.Money { return cn.Lines[i].Discount }); got != lt.Discount {
+			t.Errorf("line %d: credit notes discount %v, wanted %v", i, got, lt.Discount)
+		}
+	}
+	if _, err := inv.Credit(map[int]int{0: 1}, false, notes); err == nil {
+		t.Errorf("error expected crediting beyond invoiced quantity, none received")
+	}
+}
+
+func TestCanRejectBadCredit(t *testing.T) {
+	inv := creditInvoice()
+	shipped, _ := inv.Credit(nil, true, nil)
+	var cases = []struct {
+		name     string
+		q        map[int]int
+		shipping bool
+		previous []CreditNote
+	}{
+		{"no such line", map[int]int{2: 1}, false, nil},
+		{"negative line", map[int]int{-1: 1}, false, nil},
+		{"negative quantity", map[int]int{0: -1}, false, nil},
+		{"too many", map[int]int{0: 4}, false, nil},
+		{"shipping twice", nil, true, []CreditNote{shipped}},
+		{"mismatched note", nil, false, []CreditNote{{Quantities: []int{1}}}},
+	}
+	for _, c := range cases {
+		if got, err := inv.Credit(c.q, c.shipping, c.previous); err == nil {
+			t.Errorf("%s: error expected from Credit, none received, got %+v", c.name, got)
+		}
+	}
+	if _, err := (Invoice{Currency: "GBP"}).Credit(nil, false, nil); err == nil {
+		t.Errorf("error expected crediting invoice with no lines, none received")
+	}
+}
@@ -0,0 +1,232 @@
+// Package invoice totals invoices: lines, discounts, shipping, tax and payments.
+//
+// Totals are computed so that every section reconciles exactly: discounts are allocated to lines,
+// line amounts sum to the invoice amounts, and the balance due is the total less payments.
+package invoice
+
+import (
+	"fmt"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Line is a line of an invoice.
+type Line struct {
+	Description string
+	Quantity    int
+	UnitPrice   dough.Money
+	TaxRate     dough.Proportion
+}
+
+// Discount is a fixed amount off the invoice, before tax. It is allocated across the lines in
+// proportion to their amounts, so that each line is taxed on its discounted amount.
+type Discount struct {
+	Description string
+	Amount      dough.Money
+}
+
+// Payment is an amount already paid against the invoice.
+type Payment struct {
+	Reference string
+	Amount    dough.Money
+}
+
+// Invoice is an invoice to be totalled.
+// Shipping and payments may be left as the zero Money if there are none.
+type Invoice struct {
+	Currency        string
+	Lines           []Line
+	Discounts       []Discount
+	Shipping        dough.Money
+	ShippingTaxRate dough.Proportion
+	Payments        []Payment
+	// TaxRounding is when tax is rounded, per line or per invoice.
+	TaxRounding dough.TaxRounding
+	// Rounding is the rounding mode used for tax.
+	Rounding dough.RoundingMode
+}
+
+// LineTotal is the breakdown of a line of an invoice.
+type LineTotal struct {
+	// Amount is the quantity multiplied by the unit price.
+	Amount dough.Money
+	// Discount is the line's share of the invoice's discounts.
+	Discount dough.Money
+	// Net is Amount less Discount, the amount on which tax is charged.
+	Net   dough.Money
+	Tax   dough.Money
+	Total dough.Money
+}
+
+// Totals is the breakdown of an invoice, suitable for rendering and export.
+// Each amount is the sum of the corresponding amounts of the lines, plus shipping where relevant.
+type Totals struct {
+	Lines []LineTotal
+	// Subtotal is the sum of line amounts, before discounts.
+	Subtotal dough.Money
+	Discount dough.Money
+	// Net is Subtotal less Discount.
+	Net         dough.Money
+	Shipping    dough.Money
+	ShippingTax dough.Money
+	// Tax is the tax on all lines and shipping.
+	Tax dough.Money
+	// Total is Net plus Shipping plus Tax.
+	Total dough.Money
+	Paid  dough.Money
+	// BalanceDue is Total less Paid. It is negative if the invoice has been overpaid.
+	BalanceDue dough.Money
+}
+
+// Totals calculates the totals of inv.
+// It returns an error if the invoice has no lines, if any amount is in a different currency
+// from the invoice, if any quantity, price, discount or shipping is negative, if the discounts
+// exceed the subtotal, or if any amount can't be represented.
+func (inv Invoice) Totals() (Totals, error) {
+	if len(inv.Lines) == 0 {
+		return Totals{}, fmt.Errorf("invoice has no lines")
+	}
+	zero, err := dough.FromMinorUnits(inv.Currency, 0)
+	if err != nil {
+		return Totals{}, err
+	}
+	t := Totals{
+		Lines:       make([]LineTotal, len(inv.Lines)),
+		Subtotal:    zero,
+		Discount:    zero,
+		Shipping:    zero,
+		ShippingTax: zero,
+		Paid:        zero,
+	}
+	// Line amounts.
+	amounts := make([]dough.Money, len(inv.Lines))
+	for i, l := range inv.Lines {
+		if err := check(inv.Currency, fmt.Sprintf("line %d unit price", i+1), l.UnitPrice); err != nil {
+			return Totals{}, err
+		}
+		if l.Quantity < 0 {
+			return Totals{}, fmt.Errorf("line %d has negative quantity %d", i+1, l.Quantity)
+		}
+		if amounts[i], err = l.UnitPrice.Mul(l.Quantity); err != nil {
+			return Totals{}, err
+		}
+		if t.Subtotal, err = t.Subtotal.Add(amounts[i]); err != nil {
+			return Totals{}, err
+		}
+	}
+	// Discounts, allocated to lines.
+	for i, d := range inv.Discounts {
+		if err := check(inv.Currency, fmt.Sprintf("discount %d", i+1), d.Amount); err != nil {
+			return Totals{}, err
+		}
+		if t.Discount, err = t.Discount.Add(d.Amount); err != nil {
+			return Totals{}, err
+		}
+	}
+	if c, _ := t.Discount.Cmp(t.Subtotal); c > 0 {
+		return Totals{}, fmt.Errorf("discounts of %v exceed subtotal of %v", t.Discount, t.Subtotal)
+	}
+	discounts, err := dough.RefundAllocate(amounts, t.Discount)
+	if err != nil {
+		return Totals{}, err
+	}
+	// Shipping.
+	if inv.Shipping.MinorUnits() != 0 {
+		if err := check(inv.Currency, "shipping", inv.Shipping); err != nil {
+			return Totals{}, err
+		}
+		t.Shipping = inv.Shipping
+	}
+	// Tax, on the discounted lines and shipping.
+	taxLines := make([]dough.TaxLine, len(inv.Lines), len(inv.Lines)+1)
+	for i, l := range inv.Lines {
+		lt := &t.Lines[i]
+		lt.Amount, lt.Discount = amounts[i], discounts[i]
+		if lt.Net, err = lt.Amount.Sub(lt.Discount); err != nil {
+			return Totals{}, err
+		}
+		taxLines[i] = dough.TaxLine{Net: lt.Net, Rate: l.TaxRate}
+	}
+	taxLines = append(taxLines, dough.TaxLine{Net: t.Shipping, Rate: inv.ShippingTaxRate})
+	tax, err := dough.Tax(taxLines, inv.TaxRounding, inv.Rounding)
+	if err != nil {
+		return Totals{}, err
+	}
+	t.Tax, t.ShippingTax = tax.Total, tax.Lines[len(inv.Lines)]
+	for i := range t.Lines {
+		lt := &t.Lines[i]
+		lt.Tax = tax.Lines[i]
+		if lt.Total, err = lt.Net.Add(lt.Tax); err != nil {
+			return Totals{}, err
+		}
+	}
+	if t.Net, err = t.Subtotal.Sub(t.Discount); err != nil {
+		return Totals{}, err
+	}
+	if t.Total, err = sum(t.Net, t.Shipping, t.Tax); err != nil {
+		return Totals{}, err
+	}
+	// Payments.
+	for i, p := range inv.Payments {
+		if p.Amount.MinorUnits() == 0 {
+			continue
+		}
+		if p.Amount.Currency() != inv.Currency {
+			return Totals{}, fmt.Errorf("payment %d is in %s, invoice is in %s", i+1, p.Amount.Currency(), inv.Currency)
+		}
+		if t.Paid, err = t.Paid.Add(p.Amount); err != nil {
+			return Totals{}, err
+		}
+	}
+	if t.BalanceDue, err = t.Total.Sub(t.Paid); err != nil {
+		return Totals{}, err
+	}
+	if err := t.reconcile(); err != nil {
+		return Totals{}, err
+	}
+	return t, nil
+}
+
+// check returns an error if x isn't a non-negative amount in cur.
+func check(cur, what string, x dough.Money) error {
+	if x.Currency() != cur {
+		return fmt.Errorf("%s is in %s, invoice is in %s", what, x.Currency(), cur)
+	}
+	if x.MinorUnits() < 0 {
+		return fmt.Errorf("%s is negative (%v)", what, x)
+	}
+	return nil
+}
+
+// sum returns the sum of xs, which must not be empty.
+func sum(xs ...dough.Money) (dough.Money, error) {
+	s := xs[0]
+	for _, x := range xs[1:] {
+		var err error
+		if s, err = s.Add(x); err != nil {
+			return dough.Money{}, err
+		}
+	}
+	return s, nil
+}
+
+// reconcile checks that the lines of t sum to its totals.
+// It guards against a bug in Totals producing an invoice that doesn't add up.
+func (t Totals) reconcile() error {
+	var amount, discount, net, tax, total int64
+	for _, l := range t.Lines {
+		amount += l.Amount.MinorUnits()
+		discount += l.Discount.MinorUnits()
+		net += l.Net.MinorUnits()
+		tax += l.Tax.MinorUnits()
+		total += l.Total.MinorUnits()
+	}
+	tax += t.ShippingTax.MinorUnits()
+	total += t.Shipping.MinorUnits() + t.ShippingTax.MinorUnits()
+	if amount != t.Subtotal.MinorUnits() || discount != t.Discount.MinorUnits() || net != t.Net.MinorUnits() ||
+		tax != t.Tax.MinorUnits() || total != t.Total.MinorUnits() ||
+		t.Total.MinorUnits()-t.Paid.MinorUnits() != t.BalanceDue.MinorUnits() {
+		return fmt.Errorf("invoice doesn't reconcile: %+v", t)
+	}
+	return nil
+}
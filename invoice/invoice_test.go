@@ -0,0 +1,112 @@
+package invoice
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func gbp(amt string) dough.Money {
+	return dough.MustNew("GBP", amt)
+}
+
+func percent(p float64) dough.Proportion {
+	r, _ := dough.NewPercent(p)
+	return r
+}
+
+func TestCanTotalInvoice(t *testing.T) {
+	inv := Invoice{
+		Currency: "GBP",
+		Lines: []Line{
+			{"Widget", 3, gbp("10.00"), percent(20)},
+			{"Book", 1, gbp("5.00"), percent(0)},
+		},
+		Discounts:       []Discount{{"Loyalty", gbp("7.00")}},
+		Shipping:        gbp("4.99"),
+		ShippingTaxRate: percent(20),
+		Payments:        []Payment{{"deposit", gbp("10.00")}},
+		Rounding:        dough.HalfUp,
+	}
+	got, err := inv.Totals()
+	if err != nil {
+		t.Fatalf("error received from Totals, none expected %v", err)
+	}
+	// The £7 discount is split £6/£1 in proportion to the £30 and £5 lines.
+	var lines = []struct {
+		amount, discount, net, tax, total string
+	}{
+		{"30.00", "6.00", "24.00", "4.80", "28.80"},
+		{"5.00", "1.00", "4.00", "0.00", "4.00"},
+	}
+	for i, w := range lines {
+		l := got.Lines[i]
+		if l.Amount != gbp(w.amount) || l.Discount != gbp(w.discount) || l.Net != gbp(w.net) || l.Tax != gbp(w.tax) || l.Total != gbp(w.total) {
+			t.Errorf("line %d: wanted %+v, got %+v", i+1, w, l)
+		}
+	}
+	var totals = []struct {
+		name      string
+		got, want dough.Money
+	}{
+		{"Subtotal", got.Subtotal, gbp("35.00")},
+		{"Discount", got.Discount, gbp("7.00")},
+		{"Net", got.Net, gbp("28.00")},
+		{"Shipping", got.Shipping, gbp("4.99")},
+		{"ShippingTax", got.ShippingTax, gbp("1.00")},
+		{"Tax", got.Tax, gbp("5.80")},
+		{"Total", got.Total, gbp("38.79")},
+		{"Paid", got.Paid, gbp("10.00")},
+		{"BalanceDue", got.BalanceDue, gbp("28.79")},
+	}
+	for _, c := range totals {
+		if c.got != c.want {
+			t.Errorf("%s: wanted %v, got %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestCanTotalInvoiceWithTaxRounding(t *testing.T) {
+	lines := []Line{{"a", 1, gbp("0.33"), percent(20)}, {"b", 1, gbp("0.33"), percent(20)}, {"c", 1, gbp("0.33"), percent(20)}}
+	var cases = []struct {
+		rounding dough.TaxRounding
+		tax      string
+		total    string
+	}{
+		{dough.PerLine, "0.21", "1.20"},
+		{dough.PerInvoice, "0.20", "1.19"},
+	}
+	for _, c := range cases {
+		got, err := Invoice{Currency: "GBP", Lines: lines, TaxRounding: c.rounding, Rounding: dough.HalfUp}.Totals()
+		if err != nil || got.Tax != gbp(c.tax) || got.Total != gbp(c.total) || got.BalanceDue != gbp(c.total) {
+			t.Errorf("%v: wanted tax %s, total %s, got %v, %v (%v)", c.rounding, c.tax, c.total, got.Tax, got.Total, err)
+		}
+	}
+}
+
+func TestCanRejectBadInvoice(t *testing.T) {
+	eur := dough.MustNew("EUR", "1.00")
+	line := []Line{{"Widget", 1, gbp("10.00"), percent(20)}}
+	hi, _ := dough.MaxValue("GBP")
+	var cases = []struct {
+		name string
+		inv  Invoice
+	}{
+		{"no lines", Invoice{Currency: "GBP"}},
+		{"bad currency", Invoice{Currency: "FOO", Lines: line}},
+		{"line currency", Invoice{Currency: "GBP", Lines: []Line{{"Widget", 1, eur, percent(20)}}}},
+		{"negative quantity", Invoice{Currency: "GBP", Lines: []Line{{"Widget", -1, gbp("1.00"), percent(20)}}}},
+		{"negative price", Invoice{Currency: "GBP", Lines: []Line{{"Widget", 1, gbp("-1.00"), percent(20)}}}},
+		{"discount currency", Invoice{Currency: "GBP", Lines: line, Discounts: []Discount{{"x", eur}}}},
+		{"negative discount", Invoice{Currency: "GBP", Lines: line, Discounts: []Discount{{"x", gbp("-1.00")}}}},
+		{"discount too large", Invoice{Currency: "GBP", Lines: line, Discounts: []Discount{{"x", gbp("6.00")}, {"y", gbp("4.01")}}}},
+		{"shipping currency", Invoice{Currency: "GBP", Lines: line, Shipping: eur}},
+		{"payment currency", Invoice{Currency: "GBP", Lines: line, Payments: []Payment{{"x", eur}}}},
+		{"overflow", Invoice{Currency: "GBP", Lines: []Line{{"Widget", 2, hi, percent(0)}}}},
+	}
+	for _, c := range cases {
+		if got, err := c.inv.Totals(); err == nil {
+			t.Errorf("%s: error expected from Totals, none received, got %+v", c.name, got)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Package iso20022 formats and parses Money as ISO 20022 currency amounts,
+// such as ActiveCurrencyAndAmount and ActiveOrHistoricCurrencyAndAmount,
+// e.g. <InstdAmt Ccy="EUR">123.45</InstdAmt>.
+//
+// Amounts are non-negative decimals with a dot separator, at most 18 digits in
+// total, and no more fraction digits than the currency's ISO 4217 minor unit.
+// The direction of an amount is given by a separate indicator, e.g. CdtDbtInd.
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// MaxDigits is the maximum total number of digits in an ISO 20022 amount.
+const MaxDigits = 18
+
+// MaxFractionDigits is the maximum number of fraction digits allowed by the schema,
+// whatever the currency.
+const MaxFractionDigits = 5
+
+// Format returns the amount of m as an ISO 20022 amount, e.g. "123.45".
+// It returns an error if m is negative, or has too many digits.
+func Format(m dough.Money) (string, error) {
+	if m.MinorUnits() < 0 {
+		return "", fmt.Errorf("ISO 20022 amounts can't be negative, got %v", m)
+	}
+	if m.Exponent() > MaxFractionDigits {
+		return "", fmt.Errorf("%s has more than %d fraction digits", m.Currency(), MaxFractionDigits)
+	}
+	s := m.Amount()
+	digits := len(s)
+	if m.Exponent() > 0 {
+		digits--
+	}
+	if digits > MaxDigits {
+		return "", fmt.Errorf("%v has more than %d digits", m, MaxDigits)
+	}
+	return s, nil
+}
+
+// Parse returns the Money for an ISO 20022 amount in the given currency, e.g. Parse("EUR", "123.4").
+// It returns an error if s isn't a valid amount, or has more fraction digits than the currency's minor unit.
+func Parse(ccy, s string) (dough.Money, error) {
+	i, n := 0, 0
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		n++
+	}
+	if n == 0 {
+		return dough.Money{}, fmt.Errorf("invalid ISO 20022 amount %q", s)
+	}
+	frac := 0
+	if i < len(s) && s[i] == '.' {
+		for i++; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			frac++
+		}
+		if frac == 0 {
+			return dough.Money{}, fmt.Errorf("invalid ISO 20022 amount %q", s)
+		}
+	}
+	if i != len(s) {
+		return dough.Money{}, fmt.Errorf("invalid ISO 20022 amount %q", s)
+	}
+	if n+frac > MaxDigits {
+		return dough.Money{}, fmt.Errorf("ISO 20022 amount %q has more than %d digits", s, MaxDigits)
+	}
+	if frac > MaxFractionDigits {
+		return dough.Money{}, fmt.Errorf("ISO 20022 amount %q has more than %d fraction digits", s, MaxFractionDigits)
+	}
+	z, err := dough.FromMinorUnits(ccy, 0)
+	if err != nil {
+		return dough.Money{}, err
+	}
+	if frac > z.Exponent() {
+		return dough.Money{}, fmt.Errorf("ISO 20022 amount %q has more fraction digits than %s allows (%d)", s, ccy, z.Exponent())
+	}
+	units, err := strconv.ParseInt(s[:n]+s[min(n+1, len(s)):], 10, 64)
+	if err != nil {
+		return dough.Money{}, fmt.Errorf("invalid ISO 20022 amount %q: %v", s, err)
+	}
+	return dough.FromScaled(ccy, units, frac)
+}
+
+// Money is a dough.Money that implements xml.Marshaler and xml.Unmarshaler
+// as an ISO 20022 amount element with a Ccy attribute.
+type Money struct {
+	dough.Money
+}
+
+// MarshalXML implements xml.Marshaler.
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s, err := Format(m.Money)
+	if err != nil {
+		return err
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "Ccy"}, Value: m.Currency()})
+	return e.EncodeElement(s, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		Ccy    string `xml:"Ccy,attr"`
+		Amount string `xml:",chardata"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	if v.Ccy == "" {
+		return fmt.Errorf("ISO 20022 amount %q has no Ccy attribute", v.Amount)
+	}
+	x, err := Parse(v.Ccy, v.Amount)
+	if err != nil {
+		return err
+	}
+	m.Money = x
+	return nil
+}
@@ -0,0 +1,112 @@
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanFormatISO20022(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"EUR", "123.45", "123.45"},
+		{"EUR", "0.00", "0.00"},
+		{"JPY", "12345", "12345"},
+		{"KWD", "1.234", "1.234"},
+		{"EUR", "9999999999999999.99", "9999999999999999.99"},
+	}
+	for _, c := range cases {
+		m, _ := dough.New(c.cur, c.amt)
+		if got, err := Format(m); err != nil || got != c.want {
+			t.Errorf("Format(%v): wanted %s, got %s (%v)", m, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadISO20022Format(t *testing.T) {
+	for _, s := range []string{"EUR -0.01", "EUR 10000000000000000.00"} {
+		m, _ := dough.Parse(s)
+		if _, err := Format(m); err == nil {
+			t.Errorf("error expected from Format(%v), none received", m)
+		}
+	}
+}
+
+func TestCanParseISO20022(t *testing.T) {
+	var cases = []struct {
+		ccy  string
+		s    string
+		want string
+	}{
+		{"EUR", "123.45", "123.45"},
+		{"EUR", "123.4", "123.40"},
+		{"EUR", "123", "123.00"},
+		{"JPY", "123", "123"},
+		{"KWD", "0.5", "0.500"},
+		{"EUR", "9999999999999999.99", "9999999999999999.99"},
+	}
+	for _, c := range cases {
+		got, err := Parse(c.ccy, c.s)
+		if err != nil || got.Currency() != c.ccy || got.Amount() != c.want {
+			t.Errorf("Parse(%s, %s): wanted %s, got %v (%v)", c.ccy, c.s, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadISO20022Amount(t *testing.T) {
+	var cases = []struct {
+		ccy string
+		s   string
+	}{
+		{"EUR", ""},
+		{"EUR", "-1.00"},
+		{"EUR", "+1.00"},
+		{"EUR", "1,00"},
+		{"EUR", "1."},
+		{"EUR", ".5"},
+		{"EUR", "1.234"},
+		{"JPY", "1.0"},
+		{"EUR", "1 000.00"},
+		{"EUR", "1234567890123456789"},
+		{"FOO", "1.00"},
+	}
+	for _, c := range cases {
+		if _, err := Parse(c.ccy, c.s); err == nil {
+			t.Errorf("error expected from Parse(%s, %q), none received", c.ccy, c.s)
+		}
+	}
+}
+
+func TestCanRoundTripISO20022XML(t *testing.T) {
+	type pmt struct {
+		XMLName  xml.Name `xml:"CdtTrfTxInf"`
+		InstdAmt Money
+	}
+	m, _ := dough.New("EUR", "123.45")
+	b, err := xml.Marshal(pmt{InstdAmt: Money{m}})
+	if err != nil {
+		t.Fatalf("error received from xml.Marshal, none expected %v", err)
+	}
+	if want := `<CdtTrfTxInf><InstdAmt Ccy="EUR">123.45</InstdAmt></CdtTrfTxInf>`; string(b) != want {
+		t.Errorf("wanted %s, got %s", want, b)
+	}
+	var got pmt
+	if err := xml.Unmarshal(b, &got); err != nil {
+		t.Fatalf("error received from xml.Unmarshal, none expected %v", err)
+	}
+	if got.InstdAmt.Money != m {
+		t.Errorf("wanted %v, got %v", m, got.InstdAmt.Money)
+	}
+	for _, s := range []string{
+		`<CdtTrfTxInf><InstdAmt>123.45</InstdAmt></CdtTrfTxInf>`,
+		`<CdtTrfTxInf><InstdAmt Ccy="EUR">12.345</InstdAmt></CdtTrfTxInf>`,
+	} {
+		if err := xml.Unmarshal([]byte(s), &got); err == nil {
+			t.Errorf("error expected from xml.Unmarshal(%s), none received", s)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"golang.org/x/text/currency"
+)
+
+// CustomsItem is an item in a cross-border shipment.
+type CustomsItem struct {
+	// Category is the item's tariff category, used to look up its duty rate.
+	Category string
+	Value    Money
+}
+
+// Customs is the import regime of a destination country.
+type Customs struct {
+	// DutyRates is the duty rate for each tariff category.
+	DutyRates map[string]Proportion
+	// DutyOnShipping charges duty on shipping as well as on the items, as on a CIF
+	// (cost, insurance and freight) basis, used e.g. by the EU and UK.
+	DutyOnShipping bool
+	// VAT is the import VAT rate, charged on the items, shipping and duty.
+	VAT Proportion
+}
+
+// LandedCost is the estimated cost of importing a shipment, in the destination currency.
+type LandedCost struct {
+	Items    Money
+	Shipping Money
+	// Duty is the total duty, and DutyByCategory the duty on each category.
+	Duty           Money
+	DutyByCategory map[string]Money
+	ImportVAT      Money
+	// Total is Items plus Shipping plus Duty plus ImportVAT.
+	Total Money
+}
+
+// LandedCost estimates the cost of importing items, with shipping, into the currency to.
+//
+// Rounding is done using mode, in this order:
+//  1. Each item's value and the shipping are converted to the destination currency using rates.
+//  2. With DutyOnShipping, the shipping is allocated to the items in proportion to their converted
+//     values, exactly, so that the pieces sum to the shipping.
+//  3. Duty is calculated on the total dutiable value of each category, and rounded per category.
+//  4. Import VAT is calculated on the total of the items, shipping and duty, and rounded once.
+//
+// Shipping may be the zero Money if there is none.
+// It returns an error if to is not well formed or not recognised, if there are no items, if any
+// value is negative, if a category has no duty rate, if a conversion fails, or if an amount
+// can't be represented.
+func (cu Customs) LandedCost(items []CustomsItem, shipping Money, to string, rates RateProvider, mode RoundingMode) (LandedCost, error) {
+	c, err := currency.ParseISO(to)
+	if err != nil {
+		return LandedCost{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if len(items) == 0 {
+		return LandedCost{}, fmt.Errorf("can't estimate landed cost of no items")
+	}
+	lc := LandedCost{
+		Items:          Money{c, 0},
+		Shipping:       Money{c, 0},
+		DutyByCategory: make(map[string]Money),
+	}
+	values := make([]Money, len(items))
+	weights := make([]int64, len(items))
+	for i, it := range items {
+		if it.Value.a < 0 {
+			return LandedCost{}, fmt.Errorf("item %d has negative value %v", i, it.Value)
+		}
+		if _, ok := cu.DutyRates[it.Category]; !ok {
+			return LandedCost{}, fmt.Errorf("no duty rate for category %q", it.Category)
+		}
+		if values[i], err = Convert(it.Value, to, rates, mode); err != nil {
+			return LandedCost{}, err
+		}
+		if lc.Items, err = lc.Items.Add(values[i]); err != nil {
+			return LandedCost{}, err
+		}
+		weights[i] = int64(values[i].a)
+	}
+	if shipping.a != 0 {
+		if shipping.a < 0 {
+			return LandedCost{}, fmt.Errorf("shipping is negative (%v)", shipping)
+		}
+		if lc.Shipping, err = Convert(shipping, to, rates, mode); err != nil {
+			return LandedCost{}, err
+		}
+	}
+	// Dutiable value per category.
+	dutiable := make(map[string]*big.Rat)
+	var shares []int
+	if cu.DutyOnShipping && lc.Shipping.a != 0 {
+		if lc.Items.a == 0 {
+			for i := range weights {
+				weights[i] = 1
+			}
+		}
+		shares = allocate(lc.Shipping.a, weights)
+	}
+	for i, it := range items {
+		v, ok := dutiable[it.Category]
+		if !ok {
+			v = new(big.Rat)
+			dutiable[it.Category] = v
+		}
+		v.Add(v, big.NewRat(int64(values[i].a), 1))
+		if shares != nil {
+			v.Add(v, big.NewRat(int64(shares[i]), 1))
+		}
+	}
+	categories := make([]string, 0, len(dutiable))
+	for cat := range dutiable {
+		categories = append(categories, cat)
+	}
+	sort.Strings(categories)
+	lc.Duty = Money{c, 0}
+	for _, cat := range categories {
+		r := cu.DutyRates[cat].Rat()
+		a, err := roundAtoms(r.Mul(r, dutiable[cat]), mode)
+		if err != nil {
+			return LandedCost{}, err
+		}
+		lc.DutyByCategory[cat] = Money{c, a}
+		if lc.Duty, err = lc.Duty.Add(Money{c, a}); err != nil {
+			return LandedCost{}, err
+		}
+	}
+	base, err := lc.Items.Add(lc.Shipping)
+	if err != nil {
+		return LandedCost{}, err
+	}
+	if base, err = base.Add(lc.Duty); err != nil {
+		return LandedCost{}, err
+	}
+	if lc.ImportVAT, err = cu.VAT.Of(base, mode); err != nil {
+		return LandedCost{}, err
+	}
+	if lc.Total, err = base.Add(lc.ImportVAT); err != nil {
+		return LandedCost{}, err
+	}
+	return lc, nil
+}
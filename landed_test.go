@@ -0,0 +1,59 @@
+package dough
+
+import "testing"
+
+func TestCanEstimateLandedCost(t *testing.T) {
+	pct := func(p float64) Proportion {
+		r, _ := NewPercent(p)
+		return r
+	}
+	items := []CustomsItem{
+		{"books", MustNew("USD", "50.00")},
+		{"clothing", MustNew("USD", "100.00")},
+	}
+	var cases = []struct {
+		cu       Customs
+		shipping Money
+		duty     Money
+		clothing Money
+		vat      Money
+		total    Money
+	}{
+		// Shipping of £20 is allocated £6.67/£13.33, so clothing is dutiable on £93.33.
+		{Customs{map[string]Proportion{"books": pct(0), "clothing": pct(12)}, true, pct(20)}, MustNew("USD", "25.00"), gbp("11.20"), gbp("11.20"), gbp("30.24"), gbp("181.44")},
+		{Customs{map[string]Proportion{"books": pct(0), "clothing": pct(12)}, false, pct(20)}, MustNew("USD", "25.00"), gbp("9.60"), gbp("9.60"), gbp("29.92"), gbp("179.52")},
+		{Customs{map[string]Proportion{"books": pct(0), "clothing": pct(12)}, true, pct(20)}, Money{}, gbp("9.60"), gbp("9.60"), gbp("25.92"), gbp("155.52")},
+		{Customs{map[string]Proportion{"books": pct(2), "clothing": pct(12)}, false, Proportion{}}, Money{}, gbp("10.40"), gbp("9.60"), gbp("0.00"), gbp("130.40")},
+	}
+	for i, c := range cases {
+		got, err := c.cu.LandedCost(items, c.shipping, "GBP", testRates, HalfUp)
+		if err != nil {
+			t.Errorf("%d: error received from LandedCost, none expected %v", i, err)
+			continue
+		}
+		if got.Items != gbp("120.00") || got.Duty != c.duty || got.DutyByCategory["clothing"] != c.clothing || got.ImportVAT != c.vat || got.Total != c.total {
+			t.Errorf("%d: wanted items 120.00, duty %v (clothing %v), VAT %v, total %v, got %+v", i, c.duty, c.clothing, c.vat, c.total, got)
+		}
+	}
+}
+
+func TestCanRejectBadLandedCost(t *testing.T) {
+	cu := Customs{DutyRates: map[string]Proportion{"books": {}}}
+	var cases = []struct {
+		items    []CustomsItem
+		shipping Money
+		to       string
+	}{
+		{[]CustomsItem{{"books", gbp("1.00")}}, Money{}, "FOO"},
+		{nil, Money{}, "GBP"},
+		{[]CustomsItem{{"books", gbp("-1.00")}}, Money{}, "GBP"},
+		{[]CustomsItem{{"toys", gbp("1.00")}}, Money{}, "GBP"},
+		{[]CustomsItem{{"books", MustNew("CHF", "1.00")}}, Money{}, "GBP"},
+		{[]CustomsItem{{"books", gbp("1.00")}}, gbp("-1.00"), "GBP"},
+	}
+	for i, c := range cases {
+		if got, err := cu.LandedCost(c.items, c.shipping, c.to, testRates, HalfUp); err == nil {
+			t.Errorf("%d: error expected from LandedCost, none received, got %+v", i, got)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// RoundingAdjustment records a single rounding step.
+type RoundingAdjustment struct {
+	// Label describes the step, e.g. "VAT on line 3".
+	Label string
+	// Exact is the unrounded value, in major units.
+	Exact *big.Rat
+	// Rounded is the value after rounding.
+	Rounded Money
+}
+
+// Drift returns the amount added by rounding, in major units.
+// It is negative if rounding reduced the value.
+func (a RoundingAdjustment) Drift() *big.Rat {
+	return new(big.Rat).Sub(a.Rounded.Rat(), a.Exact)
+}
+
+// RoundingLedger records the rounding adjustments made during a computation,
+// so that cumulative drift can be reported and each adjustment accounted for.
+// The zero value is an empty ledger ready to use. It is safe for concurrent use.
+type RoundingLedger struct {
+	mu  sync.Mutex
+	adj []RoundingAdjustment
+}
+
+// Round returns the Money in the given currency nearest to exact major units, rounded using mode,
+// and records the adjustment under label.
+// It returns an error if FromRat would return an error, in which case nothing is recorded.
+func (l *RoundingLedger) Round(label, cur string, exact *big.Rat, mode RoundingMode) (Money, error) {
+	m, err := FromRat(cur, exact, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	l.Record(label, exact, m)
+	return m, nil
+}
+
+// Record records an adjustment made elsewhere, where exact (in major units) was rounded to rounded.
+func (l *RoundingLedger) Record(label string, exact *big.Rat, rounded Money) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.adj = append(l.adj, RoundingAdjustment{
+		Label:   label,
+		Exact:   new(big.Rat).Set(exact),
+		Rounded: rounded,
+	})
+}
+
+// Share allocates x between parties based on weightings, as Money.Share does,
+// and records the difference between each party's portion and their exact share.
+// The label of each adjustment is label followed by the index of the party, e.g. "tip[2]".
+func (l *RoundingLedger) Share(label string, x Money, weightings []uint) []Money {
+	// Total the weightings exactly, as Money.Share does, so that the exact shares match its allocation.
+	sum := new(big.Int)
+	for _, w := range weightings {
+		sum.Add(sum, new(big.Int).SetUint64(uint64(w)))
+	}
+	exact := make([]*big.Rat, len(weightings))
+	for i, w := range weightings {
+		if sum.Sign() == 0 {
+			exact[i] = new(big.Rat).Quo(x.Rat(), big.NewRat(int64(len(weightings)), 1))
+		} else {
+			exact[i] = new(big.Rat).Mul(x.Rat(), new(big.Rat).SetFrac(new(big.Int).SetUint64(uint64(w)), sum))
+		}
+	}
+	res := x.Share(weightings)
+	for i := range res {
+		l.Record(fmt.Sprintf("%s[%d]", label, i), exact[i], res[i])
+	}
+	return res
+}
+
+// Adjustments returns a copy of the recorded adjustments, in the order they were made.
+func (l *RoundingLedger) Adjustments() []RoundingAdjustment {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]RoundingAdjustment(nil), l.adj...)
+}
+
+// Drift returns the cumulative drift of all adjustments in the given currency, in major units.
+func (l *RoundingLedger) Drift(cur string) *big.Rat {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	d := new(big.Rat)
+	for _, a := range l.adj {
+		if a.Rounded.Currency() == cur {
+			d.Add(d, a.Drift())
+		}
+	}
+	return d
+}
@@ -0,0 +1,75 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanTrackRoundingDrift(t *testing.T) {
+	var l RoundingLedger
+	for _, s := range []string{"0.125", "0.125", "0.333"} {
+		r, _ := new(big.Rat).SetString(s)
+		if _, err := l.Round("tax", "GBP", r, HalfUp); err != nil {
+			t.Fatalf("error received from Round(%s), none expected %v", s, err)
+		}
+	}
+	r, _ := new(big.Rat).SetString("1.5")
+	l.Round("fee", "JPY", r, HalfEven)
+
+	adj := l.Adjustments()
+	if len(adj) != 4 {
+		t.Fatalf("wanted 4 adjustments, got %d", len(adj))
+	}
+	if got := adj[0].Rounded.Amount(); got != "0.13" {
+		t.Errorf("wanted 0.13, got %s", got)
+	}
+	if got := adj[0].Drift().RatString(); got != "1/200" {
+		t.Errorf("wanted drift of 1/200, got %s", got)
+	}
+	// 0.005 + 0.005 - 0.003
+	if got := l.Drift("GBP"); got.Cmp(big.NewRat(7, 1000)) != 0 {
+		t.Errorf("wanted GBP drift of 7/1000, got %s", got.RatString())
+	}
+	if got := l.Drift("JPY"); got.Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("wanted JPY drift of 1/2, got %s", got.RatString())
+	}
+	if got := l.Drift("EUR"); got.Sign() != 0 {
+		t.Errorf("wanted no EUR drift, got %s", got.RatString())
+	}
+	if _, err := l.Round("bad", "FOO", r, HalfUp); err == nil || len(l.Adjustments()) != 4 {
+		t.Errorf("error expected and nothing recorded rounding to bad currency")
+	}
+}
+
+func TestCanTrackShareDrift(t *testing.T) {
+	var l RoundingLedger
+	x, _ := New("GBP", "1.00")
+	res := l.Share("split", x, []uint{1, 1, 1})
+	if len(res) != 3 || res[0].Amount() != "0.34" {
+		t.Fatalf("unexpected allocation %v", res)
+	}
+	adj := l.Adjustments()
+	if adj[0].Label != "split[0]" || adj[0].Drift().Cmp(big.NewRat(1, 150)) != 0 {
+		t.Errorf("wanted split[0] drift of 1/150, got %s %s", adj[0].Label, adj[0].Drift().RatString())
+	}
+	// Allocation neither makes nor loses pennies.
+	if got := l.Drift("GBP"); got.Sign() != 0 {
+		t.Errorf("wanted zero total drift, got %s", got.RatString())
+	}
+	l.Share("zero", x, []uint{0, 0})
+	if got := l.Drift("GBP"); got.Sign() != 0 {
+		t.Errorf("wanted zero total drift, got %s", got.RatString())
+	}
+	// Weightings whose total overflows a uint.
+	w := ^uint(0)
+	res = l.Share("large", x, []uint{w, w, w})
+	adj = l.Adjustments()
+	for i, a := range adj[len(adj)-3:] {
+		if a.Exact.Cmp(big.NewRat(1, 3)) != 0 {
+			t.Errorf("large[%d]: wanted exact share 1/3, got %s for %v", i, a.Exact.RatString(), res[i])
+		}
+	}
+	if got := l.Drift("GBP"); got.Sign() != 0 {
+		t.Errorf("wanted zero total drift, got %s", got.RatString())
+	}
+}
@@ -0,0 +1,86 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// legacyEuroRates holds the irrevocably fixed conversion rates of the currencies replaced by the euro,
+// in units of the currency per euro.
+// https://www.ecb.europa.eu/euro/intro/html/index.en.html
+var legacyEuroRates = map[currency.Unit]string{
+	currency.MustParseISO("ATS"): "13.7603",
+	currency.MustParseISO("BEF"): "40.3399",
+	currency.MustParseISO("CYP"): "0.585274",
+	currency.MustParseISO("DEM"): "1.95583",
+	currency.MustParseISO("EEK"): "15.6466",
+	currency.MustParseISO("ESP"): "166.386",
+	currency.MustParseISO("FIM"): "5.94573",
+	currency.MustParseISO("FRF"): "6.55957",
+	currency.MustParseISO("GRD"): "340.750",
+	currency.MustParseISO("HRK"): "7.53450",
+	currency.MustParseISO("IEP"): "0.787564",
+	currency.MustParseISO("ITL"): "1936.27",
+	currency.MustParseISO("LTL"): "3.45280",
+	currency.MustParseISO("LUF"): "40.3399",
+	currency.MustParseISO("LVL"): "0.702804",
+	currency.MustParseISO("MTL"): "0.429300",
+	currency.MustParseISO("NLG"): "2.20371",
+	currency.MustParseISO("PTE"): "200.482",
+	currency.MustParseISO("SIT"): "239.640",
+	currency.MustParseISO("SKK"): "30.1260",
+}
+
+// legacyEuroPlaces is the number of decimal places the intermediate euro amount is rounded to
+// when converting between two legacy currencies. The regulations require at least three.
+const legacyEuroPlaces = 3
+
+// LegacyEuroRate returns the fixed conversion rate of a currency replaced by the euro,
+// in units of the currency per euro, e.g. 1.95583 for DEM. ok is false if cur isn't such a currency.
+func LegacyEuroRate(cur string) (r *big.Rat, ok bool) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return nil, false
+	}
+	s, ok := legacyEuroRates[c]
+	if !ok {
+		return nil, false
+	}
+	r, _ = new(big.Rat).SetString(s)
+	return r, true
+}
+
+// ConvertLegacy converts between the euro and the currencies it replaced, e.g. DEM or FRF,
+// following the rules of Council Regulation (EC) No 1103/97: the fixed rates are used exactly,
+// conversions between two legacy currencies go via the euro, with the intermediate euro amount
+// rounded to three decimal places, and results are rounded half up to the nearest minor unit.
+// It returns an error if to is not well formed or not recognised, or if the conversion isn't
+// between the euro and a legacy currency or between two legacy currencies.
+func ConvertLegacy(x Money, to string) (Money, error) {
+	c, err := currency.ParseISO(to)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	eur := currency.EUR
+	_, fromLegacy := legacyEuroRates[x.c]
+	_, toLegacy := legacyEuroRates[c]
+	if !(fromLegacy || x.c == eur) || !(toLegacy || c == eur) || x.c == c {
+		return Money{}, fmt.Errorf("Can't convert %s to %s at fixed euro rates", x.Currency(), to)
+	}
+	r := x.Rat()
+	if fromLegacy {
+		rate, _ := LegacyEuroRate(x.Currency())
+		r.Quo(r, rate)
+		if toLegacy {
+			places := big.NewRat(int64(pow10[legacyEuroPlaces]), 1)
+			r.SetFrac(roundRat(r.Mul(r, places), HalfUp), places.Num())
+		}
+	}
+	if toLegacy {
+		rate, _ := LegacyEuroRate(to)
+		r.Mul(r, rate)
+	}
+	return fromRat(c, r, HalfUp)
+}
@@ -0,0 +1,63 @@
+package dough
+
+import "testing"
+
+func TestCanConvertLegacy(t *testing.T) {
+	var cases = []struct {
+		from string
+		to   string
+		want string
+	}{
+		{"DEM 100.00", "EUR", "EUR 51.13"},
+		{"EUR 51.13", "DEM", "DEM 100.00"},
+		{"EUR 1.00", "FRF", "FRF 6.56"},
+		{"FRF 100.00", "DEM", "DEM 29.82"},
+		{"ITL 1000000", "EUR", "EUR 516.46"},
+		{"EUR 1.00", "ITL", "ITL 1936"},
+		{"DEM 1.00", "ITL", "ITL 989"},
+		{"HRK 7.53", "EUR", "EUR 1.00"},
+		{"DEM -100.00", "EUR", "EUR -51.13"},
+	}
+	for _, c := range cases {
+		x, _ := Parse(c.from)
+		got, err := ConvertLegacy(x, c.to)
+		if err != nil {
+			t.Errorf("error received from ConvertLegacy(%s, %s), none expected %v", c.from, c.to, err)
+			continue
+		}
+		if got.String() != c.want {
+			t.Errorf("ConvertLegacy(%s, %s): wanted %s, got %v", c.from, c.to, c.want, got)
+		}
+	}
+}
+
+func TestCanRejectBadLegacyConversion(t *testing.T) {
+	var cases = []struct {
+		from string
+		to   string
+	}{
+		{"GBP 1.00", "EUR"},
+		{"EUR 1.00", "USD"},
+		{"DEM 1.00", "GBP"},
+		{"EUR 1.00", "EUR"},
+		{"DEM 1.00", "DEM"},
+		{"DEM 1.00", "FOO"},
+	}
+	for _, c := range cases {
+		x, _ := Parse(c.from)
+		if got, err := ConvertLegacy(x, c.to); err == nil {
+			t.Errorf("error expected from ConvertLegacy(%s, %s), none received, got %v", c.from, c.to, got)
+		}
+	}
+}
+
+func TestCanGetLegacyEuroRate(t *testing.T) {
+	if r, ok := LegacyEuroRate("DEM"); !ok || r.FloatString(5) != "1.95583" {
+		t.Errorf("LegacyEuroRate(DEM): wanted 1.95583, got %v (%v)", r, ok)
+	}
+	for _, cur := range []string{"GBP", "EUR", "FOO"} {
+		if r, ok := LegacyEuroRate(cur); ok {
+			t.Errorf("LegacyEuroRate(%s): wanted none, got %v", cur, r)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package dough
+
+import (
+	"context"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// DefaultCurrencyFor returns the code of the currency in use in the region of tag, e.g. "GBP" for en-GB
+// or "CHF" for de-CH. If tag has no region, the most likely one is assumed, e.g. "USD" for en.
+// It returns "" if there is no such currency, e.g. for a multi-country region such as es-419.
+func DefaultCurrencyFor(tag language.Tag) string {
+	c, conf := currency.FromTag(tag)
+	if conf == language.No {
+		return ""
+	}
+	return c.String()
+}
+
+type currencyKey struct{}
+
+// WithCurrency returns a copy of ctx carrying the currency code cur, for retrieval by CurrencyFrom,
+// e.g. so that a storefront's request handling can construct Money in the request's currency.
+func WithCurrency(ctx context.Context, cur string) context.Context {
+	return context.WithValue(ctx, currencyKey{}, cur)
+}
+
+// CurrencyFrom returns the currency code carried by ctx. ok is false if it carries none.
+func CurrencyFrom(ctx context.Context) (cur string, ok bool) {
+	cur, ok = ctx.Value(currencyKey{}).(string)
+	return cur, ok
+}
@@ -0,0 +1,43 @@
+package dough
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestCanGetDefaultCurrency(t *testing.T) {
+	var cases = []struct {
+		tag  string
+		want string
+	}{
+		{"en-GB", "GBP"},
+		{"en", "USD"},
+		{"de", "EUR"},
+		{"de-CH", "CHF"},
+		{"fr-CA", "CAD"},
+		{"ja", "JPY"},
+		{"es-419", ""},
+	}
+	for _, c := range cases {
+		if got := DefaultCurrencyFor(language.MustParse(c.tag)); got != c.want {
+			t.Errorf("DefaultCurrencyFor(%s): wanted %q, got %q", c.tag, c.want, got)
+		}
+	}
+}
+
+func TestCanCarryCurrencyInContext(t *testing.T) {
+	ctx := context.Background()
+	if cur, ok := CurrencyFrom(ctx); ok {
+		t.Errorf("wanted no currency in empty context, got %q", cur)
+	}
+	ctx = WithCurrency(ctx, DefaultCurrencyFor(language.MustParse("fr-CA")))
+	cur, ok := CurrencyFrom(ctx)
+	if !ok || cur != "CAD" {
+		t.Errorf("wanted CAD from context, got %q (%v)", cur, ok)
+	}
+	if m, err := New(cur, "1.00"); err != nil || m.String() != "CAD 1.00" {
+		t.Errorf("wanted CAD 1.00, got %v (%v)", m, err)
+	}
+}
@@ -0,0 +1,85 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Points is a balance of loyalty points.
+//
+// Points aren't Money: currency.Unit only represents ISO 4217 currencies, and this package has
+// no registry of custom currencies, so points are a separate whole-number type, converted to and
+// from Money only by a LoyaltyProgram.
+type Points int64
+
+// LoyaltyProgram configures how points are earned on spending and what they are worth when redeemed.
+type LoyaltyProgram struct {
+	// EarnRate is the number of points earned per major unit spent, e.g. 1 per £1, or 1/2 per $1.
+	EarnRate Proportion
+	// EarnRounding rounds points earned to a whole number, e.g. Down so 1 point per £1 earns
+	// 12 points for £12.99.
+	EarnRounding RoundingMode
+	// PointValue is the value of one point, which may be a fraction of a minor unit,
+	// e.g. NewUnitPrice("GBP", "0.005") for half a penny.
+	PointValue UnitPrice
+	// ValueRounding rounds the value of points to the currency's minor unit.
+	ValueRounding RoundingMode
+}
+
+// Earn returns the points earned on spend. Negative spend, e.g. a refund, earns negative points.
+// It returns an error if spend isn't in the program's currency, or the points can't be represented.
+func (p LoyaltyProgram) Earn(spend Money) (Points, error) {
+	if err := p.check(spend); err != nil {
+		return 0, err
+	}
+	r := p.EarnRate.Rat()
+	i := roundRat(r.Mul(r, spend.Rat()), p.EarnRounding)
+	if !i.IsInt64() {
+		return 0, fmt.Errorf("%w: %s points", ErrOutOfRange, i)
+	}
+	return Points(i.Int64()), nil
+}
+
+// Value returns the value of pts in the program's currency, rounded using ValueRounding.
+// It returns an error if the value can't be represented.
+func (p LoyaltyProgram) Value(pts Points) (Money, error) {
+	return p.PointValue.Extend(big.NewRat(int64(pts), 1), p.ValueRounding)
+}
+
+// Redeem returns the points to burn, from a balance of available points, towards paying amount,
+// and the discount they give. It burns the fewest points whose value covers amount, or all of
+// available if they aren't enough; the discount is their value, but never more than amount.
+// It returns an error if amount isn't in the program's currency or is negative, if available
+// is negative, or if PointValue isn't positive.
+func (p LoyaltyProgram) Redeem(available Points, amount Money) (burn Points, discount Money, err error) {
+	if err := p.check(amount); err != nil {
+		return 0, Money{}, err
+	}
+	if amount.a < 0 || available < 0 {
+		return 0, Money{}, fmt.Errorf("can't redeem %d points against %v", available, amount)
+	}
+	v := p.PointValue.Rat()
+	if v.Sign() <= 0 {
+		return 0, Money{}, fmt.Errorf("point value must be positive, got %v", p.PointValue)
+	}
+	need := roundRat(new(big.Rat).Quo(amount.Rat(), v), Ceiling)
+	burn = available
+	if need.IsInt64() && need.Int64() < int64(available) {
+		burn = Points(need.Int64())
+	}
+	if discount, err = p.Value(burn); err != nil {
+		return 0, Money{}, err
+	}
+	if discount.a > amount.a {
+		discount = amount
+	}
+	return burn, discount, nil
+}
+
+// check returns an error if x isn't in the program's currency.
+func (p LoyaltyProgram) check(x Money) error {
+	if x.c != p.PointValue.c {
+		return fmt.Errorf("Can't use %s with a %s loyalty program", x.Currency(), p.PointValue.Currency())
+	}
+	return nil
+}
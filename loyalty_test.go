@@ -0,0 +1,87 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func testProgram() LoyaltyProgram {
+	v, _ := NewUnitPrice("GBP", "0.005")
+	return LoyaltyProgram{
+		EarnRate:      NewProportion(big.NewRat(1, 1)),
+		EarnRounding:  Down,
+		PointValue:    v,
+		ValueRounding: Down,
+	}
+}
+
+func TestCanEarnPoints(t *testing.T) {
+	p := testProgram()
+	var cases = []struct {
+		spend Money
+		want  Points
+	}{
+		{gbp("12.99"), 12},
+		{gbp("0.99"), 0},
+		{gbp("100.00"), 100},
+		{gbp("-12.99"), -12},
+	}
+	for _, c := range cases {
+		if got, err := p.Earn(c.spend); err != nil || got != c.want {
+			t.Errorf("Earn(%v): wanted %d, got %d (%v)", c.spend, c.want, got, err)
+		}
+	}
+	p.EarnRate = NewProportion(big.NewRat(3, 2))
+	p.EarnRounding = HalfUp
+	if got, _ := p.Earn(gbp("1.00")); got != 2 {
+		t.Errorf("Earn(1.00) at 1.5 points per pound, rounding half up: wanted 2, got %d", got)
+	}
+	if _, err := p.Earn(MustNew("EUR", "1.00")); err == nil {
+		t.Errorf("error expected from Earn in different currency, none received")
+	}
+}
+
+func TestCanRedeemPoints(t *testing.T) {
+	p := testProgram()
+	if got, err := p.Value(199); err != nil || got != gbp("0.99") {
+		t.Errorf("Value(199): wanted 0.99, got %v (%v)", got, err)
+	}
+	var cases = []struct {
+		available Points
+		amount    Money
+		burn      Points
+		discount  Money
+	}{
+		{1000, gbp("2.00"), 400, gbp("2.00")},
+		{1000, gbp("2.01"), 402, gbp("2.01")},
+		{100, gbp("2.00"), 100, gbp("0.50")},
+		{201, gbp("10.00"), 201, gbp("1.00")},
+		{0, gbp("2.00"), 0, gbp("0.00")},
+		{1000, gbp("0.00"), 0, gbp("0.00")},
+	}
+	for _, c := range cases {
+		burn, discount, err := p.Redeem(c.available, c.amount)
+		if err != nil || burn != c.burn || discount != c.discount {
+			t.Errorf("Redeem(%d, %v): wanted %d points for %v, got %d for %v (%v)", c.available, c.amount, c.burn, c.discount, burn, discount, err)
+		}
+	}
+}
+
+func TestCanRejectBadRedemption(t *testing.T) {
+	p := testProgram()
+	var cases = []struct {
+		p         LoyaltyProgram
+		available Points
+		amount    Money
+	}{
+		{p, 100, MustNew("EUR", "1.00")},
+		{p, 100, gbp("-1.00")},
+		{p, -1, gbp("1.00")},
+		{LoyaltyProgram{PointValue: UnitPriceOf(gbp("0.00"))}, 100, gbp("1.00")},
+	}
+	for _, c := range cases {
+		if burn, _, err := c.p.Redeem(c.available, c.amount); err == nil {
+			t.Errorf("error expected from Redeem(%d, %v), none received, got %d", c.available, c.amount, burn)
+		}
+	}
+}
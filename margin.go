@@ -0,0 +1,56 @@
+package dough
+
+import "fmt"
+
+// Pricing relates the cost of an item to the price it is sold at, as calculated by Margin and ApplyMarkup.
+type Pricing struct {
+	Cost   Money
+	Price  Money
+	Profit Money
+	// Margin is the profit as a percentage of the price, or 0 if the price is zero.
+	Margin float64
+	// Markup is the profit as a percentage of the cost, or 0 if the cost is zero.
+	Markup float64
+}
+
+// Margin returns the pricing of an item bought for cost and sold for sell.
+// It returns an error if cost and sell are in different currencies, or one wrapping ErrOutOfRange
+// if the profit would overflow.
+func Margin(cost, sell Money) (Pricing, error) {
+	profit, err := sell.Sub(cost)
+	if err != nil {
+		return Pricing{}, err
+	}
+	p := Pricing{
+		Cost:   cost,
+		Price:  sell,
+		Profit: profit,
+	}
+	if sell.a != 0 {
+		p.Margin = percentage(profit.a, sell.a)
+	}
+	if cost.a != 0 {
+		p.Markup = percentage(profit.a, cost.a)
+	}
+	return p, nil
+}
+
+// ApplyMarkup returns the pricing of an item bought for cost and sold at a markup of percent% of cost.
+// The markup is rounded to the currency's minor unit using mode, so Markup in the result may differ
+// slightly from percent.
+// It returns an error if cost is negative, or percent is negative or not finite, or one wrapping
+// ErrOutOfRange if the price would overflow.
+func ApplyMarkup(cost Money, percent float64, mode RoundingMode) (Pricing, error) {
+	if cost.a < 0 {
+		return Pricing{}, fmt.Errorf("can't mark up negative cost %v", cost)
+	}
+	markup, err := percentOf(cost, percent, mode)
+	if err != nil {
+		return Pricing{}, err
+	}
+	sell, err := cost.Add(markup)
+	if err != nil {
+		return Pricing{}, err
+	}
+	return Margin(cost, sell)
+}
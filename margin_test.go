@@ -0,0 +1,86 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCanCalculateMargin(t *testing.T) {
+	var cases = []struct {
+		cost   string
+		sell   string
+		profit string
+		margin float64
+		markup float64
+	}{
+		{"60.00", "100.00", "40.00", 40, 66.66666666666667},
+		{"100.00", "100.00", "0.00", 0, 0},
+		{"100.00", "80.00", "-20.00", -25, -20},
+		{"0.00", "10.00", "10.00", 100, 0},
+		{"10.00", "0.00", "-10.00", 0, -100},
+	}
+	for _, c := range cases {
+		got, err := Margin(gbp(c.cost), gbp(c.sell))
+		if err != nil {
+			t.Errorf("error received from Margin(%s, %s), none expected %v", c.cost, c.sell, err)
+			continue
+		}
+		if got.Profit.Amount() != c.profit || got.Margin != c.margin || got.Markup != c.markup {
+			t.Errorf("Margin(%s, %s): wanted profit %s, margin %v, markup %v, got %s, %v, %v", c.cost, c.sell, c.profit, c.margin, c.markup, got.Profit.Amount(), got.Margin, got.Markup)
+		}
+	}
+	eur, _ := New("EUR", "1.00")
+	if _, err := Margin(gbp("1.00"), eur); err == nil {
+		t.Errorf("error expected from Margin with different currencies, none received")
+	}
+}
+
+func TestCanApplyMarkup(t *testing.T) {
+	var cases = []struct {
+		cost    string
+		percent float64
+		mode    RoundingMode
+		price   string
+		margin  float64
+	}{
+		{"60.00", 50, HalfUp, "90.00", 33.333333333333336},
+		{"10.00", 33.335, HalfUp, "13.33", 24.981245311327834},
+		{"10.00", 33.335, Up, "13.34", 25.037481259370313},
+		{"9.99", 0, HalfUp, "9.99", 0},
+		{"0.00", 50, HalfUp, "0.00", 0},
+	}
+	for _, c := range cases {
+		got, err := ApplyMarkup(gbp(c.cost), c.percent, c.mode)
+		if err != nil {
+			t.Errorf("error received from ApplyMarkup(%s, %v), none expected %v", c.cost, c.percent, err)
+			continue
+		}
+		if got.Price.Amount() != c.price || got.Margin != c.margin {
+			t.Errorf("ApplyMarkup(%s, %v, %v): wanted price %s, margin %v, got %s, %v", c.cost, c.percent, c.mode, c.price, c.margin, got.Price.Amount(), got.Margin)
+		}
+	}
+	for _, p := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := ApplyMarkup(gbp("1.00"), p, HalfUp); err == nil {
+			t.Errorf("error expected from ApplyMarkup(1.00, %v), none received", p)
+		}
+	}
+	if _, err := ApplyMarkup(gbp("-1.00"), 10, HalfUp); err == nil {
+		t.Errorf("error expected from ApplyMarkup with negative cost, none received")
+	}
+}
+
+func TestCanRejectMarginOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	lo, _ := MinValue("GBP")
+	if _, err := ApplyMarkup(hi, 10, HalfUp); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ApplyMarkup(%v, 10): wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if _, err := Margin(lo, hi); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Margin(%v, %v): wanted ErrOutOfRange, got %v", lo, hi, err)
+	}
+	// Percentages are calculated exactly, however large the profit.
+	if got, err := Margin(gbp("0.01"), MustNew("GBP", "90000000000000000.01")); err != nil || got.Markup != 9e20 {
+		t.Errorf("wanted a markup of 9e20%%, got %v (%v)", got.Markup, err)
+	}
+}
@@ -0,0 +1,97 @@
+// Package metrics exposes Money as Prometheus metrics using github.com/prometheus/client_golang,
+// with a "currency" label so that amounts in different currencies are never added together.
+//
+// Gauges hold amounts in major units as float64, e.g. 123.45 for £123.45, which suits balances
+// that go up and down. Counters hold minor units, e.g. 12345 for £123.45, which suits totals such
+// as revenue: a float64 holds every whole number of minor units exactly up to 2^53, so a counter
+// stays exact as long as its running total is at most 2^53 minor units, e.g. about £90 trillion.
+// Beyond that, additions are rounded to the nearest float64 and the total drifts.
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CurrencyLabel is the name of the label holding the currency code.
+const CurrencyLabel = "currency"
+
+// Float returns x in major units as the nearest float64, e.g. 123.45 for £123.45.
+// The result is accurate to the minor unit for amounts up to 2^53 minor units, but can't hold
+// most decimal amounts exactly, so it should be used only for display and monitoring.
+func Float(x dough.Money) float64 {
+	f, _ := x.Rat().Float64()
+	return f
+}
+
+// Gauge is a gauge of Money in major units, with a series for each currency.
+// A Gauge is a prometheus.Collector, so it can be registered.
+type Gauge struct {
+	v *prometheus.GaugeVec
+}
+
+// NewGauge returns a Gauge with the given options and the variable labels labels,
+// as well as CurrencyLabel.
+func NewGauge(opts prometheus.GaugeOpts, labels ...string) *Gauge {
+	return &Gauge{prometheus.NewGaugeVec(opts, append([]string{CurrencyLabel}, labels...))}
+}
+
+// Set sets the gauge for x's currency and the values of the other labels to x, in major units.
+// It returns an error if the number of label values doesn't match the labels.
+func (g *Gauge) Set(x dough.Money, labelValues ...string) error {
+	m, err := g.v.GetMetricWithLabelValues(append([]string{x.Currency()}, labelValues...)...)
+	if err != nil {
+		return err
+	}
+	m.Set(Float(x))
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (g *Gauge) Describe(ch chan<- *prometheus.Desc) {
+	g.v.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (g *Gauge) Collect(ch chan<- prometheus.Metric) {
+	g.v.Collect(ch)
+}
+
+// Counter is a counter of Money in minor units, with a series for each currency.
+// A Counter is a prometheus.Collector, so it can be registered.
+type Counter struct {
+	v *prometheus.CounterVec
+}
+
+// NewCounter returns a Counter with the given options and the variable labels labels,
+// as well as CurrencyLabel. The name should say that the unit is the minor unit,
+// e.g. "revenue_minor_units_total".
+func NewCounter(opts prometheus.CounterOpts, labels ...string) *Counter {
+	return &Counter{prometheus.NewCounterVec(opts, append([]string{CurrencyLabel}, labels...))}
+}
+
+// Add adds x, in minor units, to the counter for x's currency and the values of the other labels.
+// It returns an error if x is negative, since counters can only go up.
+func (c *Counter) Add(x dough.Money, labelValues ...string) error {
+	if x.MinorUnits() < 0 {
+		return fmt.Errorf("can't add negative amount %v to a counter", x)
+	}
+	m, err := c.v.GetMetricWithLabelValues(append([]string{x.Currency()}, labelValues...)...)
+	if err != nil {
+		return err
+	}
+	m.Add(float64(x.MinorUnits()))
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *Counter) Describe(ch chan<- *prometheus.Desc) {
+	c.v.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Counter) Collect(ch chan<- prometheus.Metric) {
+	c.v.Collect(ch)
+}
@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCanConvertToFloat(t *testing.T) {
+	var cases = []struct {
+		cur, amt string
+		want     float64
+	}{
+		{"GBP", "123.45", 123.45},
+		{"GBP", "-0.01", -0.01},
+		{"JPY", "1000", 1000},
+		{"KWD", "1.234", 1.234},
+	}
+	for _, c := range cases {
+		if got := Float(dough.MustNew(c.cur, c.amt)); got != c.want {
+			t.Errorf("Float(%s %s): wanted %v, got %v", c.cur, c.amt, c.want, got)
+		}
+	}
+}
+
+func TestCanSetGauge(t *testing.T) {
+	g := NewGauge(prometheus.GaugeOpts{Name: "balance", Help: "Account balance."}, "account")
+	for _, x := range []dough.Money{dough.MustNew("GBP", "100.00"), dough.MustNew("GBP", "-12.34"), dough.MustNew("JPY", "500")} {
+		if err := g.Set(x, "a"); err != nil {
+			t.Errorf("error received from Set(%v), none expected %v", x, err)
+		}
+	}
+	if err := g.Set(dough.MustNew("GBP", "1.00")); err == nil {
+		t.Errorf("error expected from Set with too few label values, none received")
+	}
+	if err := g.Set(dough.MustNew("GBP", "1.00"), "a", "extra"); err == nil {
+		t.Errorf("error expected from Set with too many label values, none received")
+	}
+	want := `
+# HELP balance Account balance.
+# TYPE balance gauge
+balance{account="a",currency="GBP"} -12.34
+balance{account="a",currency="JPY"} 500
+`
+	if err := testutil.CollectAndCompare(g, strings.NewReader(want)); err != nil {
+		t.Errorf("unexpected gauge: %v", err)
+	}
+}
+
+func TestCanAddToCounter(t *testing.T) {
+	c := NewCounter(prometheus.CounterOpts{Name: "revenue_minor_units_total", Help: "Revenue."})
+	for _, x := range []dough.Money{dough.MustNew("GBP", "0.10"), dough.MustNew("GBP", "0.20"), dough.MustNew("EUR", "5.00")} {
+		if err := c.Add(x); err != nil {
+			t.Errorf("error received from Add(%v), none expected %v", x, err)
+		}
+	}
+	if err := c.Add(dough.MustNew("GBP", "-1.00")); err == nil {
+		t.Errorf("error expected from Add with negative amount, none received")
+	}
+	if err := c.Add(dough.MustNew("GBP", "1.00"), "extra"); err == nil {
+		t.Errorf("error expected from Add with too many label values, none received")
+	}
+	// 0.1 + 0.2 would not be 0.3 in floating point major units.
+	want := `
+# HELP revenue_minor_units_total Revenue.
+# TYPE revenue_minor_units_total counter
+revenue_minor_units_total{currency="EUR"} 500
+revenue_minor_units_total{currency="GBP"} 30
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Errorf("unexpected counter: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// microsPerUnit is the number of micros in a major unit.
+const microsPerUnit = 1000000
+
+// FromMicros returns the Money nearest to the given number of micros, millionths of a major unit,
+// as used by ad-tech APIs such as Google Ads, e.g. FromMicros("GBP", 1234500000) is £1234.50.
+// Micros finer than the currency's minor unit are rounded using the default rounding mode, as set by
+// SetDefaultRounding; use FromMicrosRounded to choose the mode.
+// It returns an error if cur is not well formed or not recognised.
+func FromMicros(cur string, micros int64) (Money, error) {
+	return FromMicrosRounded(cur, micros, Default)
+}
+
+// FromMicrosRounded is like FromMicros, but rounds using mode.
+func FromMicrosRounded(cur string, micros int64, mode RoundingMode) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	return fromRat(c, big.NewRat(micros, microsPerUnit), mode)
+}
+
+// Micros returns the amount of the Money in micros, millionths of a major unit, e.g. 1234500000 for £1234.50.
+// This is exact for currencies with up to six decimal places; amounts in currencies with more are rounded using
+// the default rounding mode. Amounts too large to be held in an int64, beyond about 9.2 trillion major units,
+// are clamped to math.MinInt64 or math.MaxInt64; use MicrosRounded to detect that.
+func (x Money) Micros() int64 {
+	m, err := x.MicrosRounded(Default)
+	if err != nil {
+		if x.a < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return m
+}
+
+// MicrosRounded is like Micros, but rounds using mode, and returns an error if the result doesn't fit in an int64.
+func (x Money) MicrosRounded(mode RoundingMode) (int64, error) {
+	r := x.Rat()
+	r.Mul(r, big.NewRat(microsPerUnit, 1))
+	m := roundRat(r, mode)
+	if !m.IsInt64() {
+		return 0, fmt.Errorf("%w: %v in micros", ErrOutOfRange, x)
+	}
+	return m.Int64(), nil
+}
@@ -0,0 +1,82 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCanConvertFromMicros(t *testing.T) {
+	var cases = []struct {
+		cur    string
+		micros int64
+		mode   RoundingMode
+		want   string
+	}{
+		{"GBP", 1234500000, HalfUp, "1234.50"},
+		{"GBP", -1234500000, HalfUp, "-1234.50"},
+		{"GBP", 0, HalfUp, "0.00"},
+		{"GBP", 1, Up, "0.01"},
+		{"GBP", 5000, HalfUp, "0.01"},
+		{"GBP", 5000, HalfEven, "0.00"},
+		{"GBP", 15000, HalfEven, "0.02"},
+		{"GBP", -5000, HalfUp, "-0.01"},
+		{"GBP", 129900, Down, "0.12"},
+		{"JPY", 1500000, HalfEven, "2"},
+		{"KWD", 1234567, HalfUp, "1.235"},
+		{"GBP", math.MaxInt64, Down, "9223372036854.77"},
+		{"GBP", math.MinInt64, Floor, "-9223372036854.78"},
+	}
+	for _, c := range cases {
+		got, err := FromMicrosRounded(c.cur, c.micros, c.mode)
+		if err != nil || got.Currency() != c.cur || got.Amount() != c.want {
+			t.Errorf("FromMicrosRounded(%s, %d, %v): wanted %s, got %v (%v)", c.cur, c.micros, c.mode, c.want, got, err)
+		}
+	}
+	if got, err := FromMicros("GBP", 12345000); err != nil || got.Amount() != "12.35" {
+		t.Errorf("FromMicros(GBP, 12345000): wanted 12.35, got %v (%v)", got, err)
+	}
+	if _, err := FromMicros("FOO", 1); err == nil {
+		t.Errorf("error expected from FromMicros with bad currency, none received")
+	}
+}
+
+func TestCanConvertToMicros(t *testing.T) {
+	var cases = []struct {
+		x    Money
+		want int64
+	}{
+		{gbp("1234.50"), 1234500000},
+		{gbp("-0.01"), -10000},
+		{gbp("0.00"), 0},
+		{MustNew("JPY", "7"), 7000000},
+		{MustNew("KWD", "1.234"), 1234000},
+		{gbp("92233720368547758.07"), math.MaxInt64},
+		{gbp("-92233720368547758.07"), math.MinInt64},
+	}
+	for _, c := range cases {
+		if got := c.x.Micros(); got != c.want {
+			t.Errorf("%v.Micros(): wanted %d, got %d", c.x, c.want, got)
+		}
+	}
+	if _, err := gbp("9223372036854.78").MicrosRounded(HalfUp); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("MicrosRounded(GBP 9223372036854.78): wanted ErrOutOfRange, got %v", err)
+	}
+	defer SetExponent("XDR", 2)
+	if err := SetExponent("XDR", 8); err != nil {
+		t.Fatalf("error received from SetExponent(XDR, 8), none expected %v", err)
+	}
+	xdr := MustNew("XDR", "1.23456789")
+	if got, err := xdr.MicrosRounded(Down); err != nil || got != 1234567 {
+		t.Errorf("MicrosRounded(XDR 1.23456789, Down): wanted 1234567, got %d (%v)", got, err)
+	}
+	if got, err := xdr.MicrosRounded(HalfUp); err != nil || got != 1234568 {
+		t.Errorf("MicrosRounded(XDR 1.23456789, HalfUp): wanted 1234568, got %d (%v)", got, err)
+	}
+	for _, x := range []Money{gbp("1.23"), gbp("-1.23"), MustNew("KWD", "0.001")} {
+		got, _ := FromMicros(x.Currency(), x.Micros())
+		if got != x {
+			t.Errorf("round trip of %v through micros: got %v", x, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Monetary is the view of an amount of money that Money implements, so that higher-level code,
+// such as baskets and ledgers, can accept fakes in tests, or amounts backed by something other than Money.
+//
+// Its arithmetic methods take and return Monetary, so that a fake can implement them in its own terms.
+// They can't be called Add, Sub and Cmp, as Money's methods of those names take Money.
+type Monetary interface {
+	// Currency gets the 3-letter ISO 4217 currency code.
+	Currency() string
+	// Exponent gets the number of decimal places of MinorUnits.
+	Exponent() int
+	// MinorUnits gets the amount as a whole number of 10^-Exponent units.
+	MinorUnits() int64
+	// Rat gets the exact amount in major units.
+	Rat() *big.Rat
+	// Amount gets the amount in major units as a decimal string.
+	Amount() string
+	// AddMonetary returns the sum of the amount and y. It returns an error if they're in different currencies,
+	// or the sum can't be represented.
+	AddMonetary(y Monetary) (Monetary, error)
+	// SubMonetary returns the amount less y. It returns an error if they're in different currencies,
+	// or the difference can't be represented.
+	SubMonetary(y Monetary) (Monetary, error)
+	// CmpMonetary compares the amount with y, returning -1, 0 or +1 as it is less than, equal to or greater than y.
+	// It returns an error if they're in different currencies.
+	CmpMonetary(y Monetary) (int, error)
+}
+
+var _ Monetary = Money{}
+
+// ToMoney returns m as a Money. If m is a Money, it is returned as is.
+// It returns an error if m's currency isn't recognised, or its amount can't be represented exactly
+// in the currency's minor units, or one wrapping ErrOutOfRange if it is beyond Money's range.
+func ToMoney(m Monetary) (Money, error) {
+	switch x := m.(type) {
+	case Money:
+		return x, nil
+	case Money128:
+		return x.Money()
+	}
+	return FromScaled(m.Currency(), m.MinorUnits(), m.Exponent())
+}
+
+// AddMonetary implements Monetary. It is like Add, but y may be any Monetary, which is converted with ToMoney.
+func (x Money) AddMonetary(y Monetary) (Monetary, error) {
+	z, err := ToMoney(y)
+	if err == nil {
+		z, err = x.Add(z)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SubMonetary implements Monetary. It is like Sub, but y may be any Monetary, which is converted with ToMoney.
+func (x Money) SubMonetary(y Monetary) (Monetary, error) {
+	z, err := ToMoney(y)
+	if err == nil {
+		z, err = x.Sub(z)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// CmpMonetary implements Monetary. It is like Cmp, but y may be any Monetary, which is compared exactly.
+func (x Money) CmpMonetary(y Monetary) (int, error) {
+	if x.Currency() != y.Currency() {
+		return 0, fmt.Errorf("Can't compare different currencies (%s and %s)", x.Currency(), y.Currency())
+	}
+	return x.Rat().Cmp(y.Rat()), nil
+}
@@ -0,0 +1,109 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// fakeMonetary is a Monetary with a fixed exponent of 4.
+type fakeMonetary struct {
+	cur   string
+	units int64
+}
+
+func (f fakeMonetary) Currency() string  { return f.cur }
+func (f fakeMonetary) Exponent() int     { return 4 }
+func (f fakeMonetary) MinorUnits() int64 { return f.units }
+func (f fakeMonetary) Rat() *big.Rat     { return big.NewRat(f.units, 10000) }
+func (f fakeMonetary) Amount() string    { return f.Rat().FloatString(4) }
+
+func (f fakeMonetary) AddMonetary(y Monetary) (Monetary, error) {
+	if y.Currency() != f.cur || y.Exponent() != 4 {
+		return nil, fmt.Errorf("can't add %s %s to fake", y.Currency(), y.Amount())
+	}
+	return fakeMonetary{f.cur, f.units + y.MinorUnits()}, nil
+}
+
+func (f fakeMonetary) SubMonetary(y Monetary) (Monetary, error) {
+	if y.Currency() != f.cur || y.Exponent() != 4 {
+		return nil, fmt.Errorf("can't subtract %s %s from fake", y.Currency(), y.Amount())
+	}
+	return fakeMonetary{f.cur, f.units - y.MinorUnits()}, nil
+}
+
+func (f fakeMonetary) CmpMonetary(y Monetary) (int, error) {
+	if y.Currency() != f.cur {
+		return 0, fmt.Errorf("can't compare %s %s with fake", y.Currency(), y.Amount())
+	}
+	return f.Rat().Cmp(y.Rat()), nil
+}
+
+// totalOf is the kind of code Monetary is for: it works with Money or a fake.
+func totalOf(ms ...Monetary) (Monetary, error) {
+	total := ms[0]
+	for _, m := range ms[1:] {
+		var err error
+		if total, err = total.AddMonetary(m); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+func TestCanConvertMonetaryToMoney(t *testing.T) {
+	var cases = []struct {
+		m    Monetary
+		want string
+	}{
+		{gbp("1.23"), "GBP 1.23"},
+		{fakeMonetary{"GBP", 12300}, "GBP 1.23"},
+		{fakeMonetary{"JPY", 50000}, "JPY 5"},
+	}
+	for _, c := range cases {
+		got, err := ToMoney(c.m)
+		if err != nil || got.String() != c.want {
+			t.Errorf("ToMoney(%s %s): wanted %s, got %v (%v)", c.m.Currency(), c.m.Amount(), c.want, got, err)
+		}
+	}
+	for _, m := range []Monetary{fakeMonetary{"GBP", 12345}, fakeMonetary{"FOO", 1}} {
+		if got, err := ToMoney(m); err == nil {
+			t.Errorf("error expected from ToMoney(%s %s), none received, got %v", m.Currency(), m.Amount(), got)
+		}
+	}
+}
+
+func TestCanDoArithmeticWithMonetary(t *testing.T) {
+	if got, err := totalOf(gbp("1.23"), gbp("2.00"), fakeMonetary{"GBP", 5000}); err != nil || got.Amount() != "3.73" {
+		t.Errorf("total of Money and fake: wanted 3.73, got %v (%v)", got, err)
+	}
+	if got, err := totalOf(fakeMonetary{"GBP", 1}, fakeMonetary{"GBP", 2}); err != nil || got.Amount() != "0.0003" {
+		t.Errorf("total of fakes: wanted 0.0003, got %v (%v)", got, err)
+	}
+	if _, err := totalOf(gbp("1.00"), fakeMonetary{"GBP", 1}); err == nil {
+		t.Errorf("error expected adding a fake that isn't representable in GBP, none received")
+	}
+	if _, err := totalOf(gbp("1.00"), MustNew("EUR", "1.00")); err == nil {
+		t.Errorf("error expected adding different currencies, none received")
+	}
+	if got, err := gbp("1.00").SubMonetary(fakeMonetary{"GBP", 2500}); err != nil || got.Amount() != "0.75" {
+		t.Errorf("GBP 1.00 less fake 0.25: wanted 0.75, got %v (%v)", got, err)
+	}
+	var cmps = []struct {
+		y    Monetary
+		want int
+	}{
+		{fakeMonetary{"GBP", 10001}, -1},
+		{fakeMonetary{"GBP", 10000}, 0},
+		{fakeMonetary{"GBP", 9999}, 1},
+		{gbp("1.00"), 0},
+	}
+	for _, c := range cmps {
+		if got, err := gbp("1.00").CmpMonetary(c.y); err != nil || got != c.want {
+			t.Errorf("CmpMonetary(GBP 1.00, %s): wanted %d, got %d (%v)", c.y.Amount(), c.want, got, err)
+		}
+	}
+	if _, err := gbp("1.00").CmpMonetary(fakeMonetary{"EUR", 1}); err == nil {
+		t.Errorf("error expected comparing different currencies, none received")
+	}
+}
@@ -1,15 +1,22 @@
 // Package dough provides arithmetic for monetary amounts.
 package dough
 
+//go:generate go run gen_constructors.go
+
 import (
 	"fmt"
 	"golang.org/x/text/currency"
 	"math"
-	"regexp"
+	"math/big"
 	"strconv"
 )
 
 // Money is a value object representing a monetary amount.
+//
+// Money is comparable: two values are == if and only if they have the same
+// currency and amount, so Money is safe to use as a map key.
+//
+// The zero value is XXX 0.00: zero in ISO 4217's code for no currency.
 type Money struct {
 	// Currency
 	c currency.Unit
@@ -20,6 +27,8 @@ type Money struct {
 // New returns a new Money instance for the given currency and amount.
 // cur is an 3-letter ISO 4217 currency code.
 // amt is a string representation of the amount, e.g. "123.45".
+// If amt has a fractional part, it must have exactly as many digits as the
+// currency's minor unit, e.g. "123.45" for GBP, "123" for JPY.
 // It returns an error if cur is not well formed or not recognised,
 // or if amt cannot be parsed.
 func New(cur, amt string) (Money, error) {
@@ -27,10 +36,24 @@ func New(cur, amt string) (Money, error) {
 	if err != nil {
 		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
 	}
+	return newMoney(c, amt)
+}
 
+// MustNew is like New, but panics on error.
+// It simplifies the initialisation of package-level variables.
+func MustNew(cur, amt string) Money {
+	m, err := New(cur, amt)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// newMoney returns a new Money instance for the given currency unit and amount.
+func newMoney(c currency.Unit, amt string) (Money, error) {
 	a, err := strToInt(c, amt)
 	if err != nil {
-		return Money{}, fmt.Errorf("couldn't parse amount: %v", err)
+		return Money{}, fmt.Errorf("couldn't parse amount: %w", err)
 	}
 	return Money{
 		c: c,
@@ -38,50 +61,168 @@ func New(cur, amt string) (Money, error) {
 	}, nil
 }
 
-func strToInt(c currency.Unit, amt string) (int, error) {
-	// TODO: Capture sub-units based on currency exponent.
-	// https://en.wikipedia.org/wiki/ISO_4217#Treatment_of_minor_currency_units_.28the_.22exponent.22.29
-	re := regexp.MustCompile("^(-)?(\\d+)(\\.([\\d]{2}))?$")
-	m := re.FindStringSubmatch(amt)
-	if len(m) == 0 {
-		return 0, fmt.Errorf("unable to parse amount: %s", amt)
-	}
-	digits := m[2] + m[4]
-	a, err := strconv.Atoi(digits)
+// FromMinorUnits returns a new Money for the given currency and amount in minor units,
+// e.g. FromMinorUnits("GBP", 12345) is £123.45.
+// It returns an error if cur is not well formed or not recognised.
+func FromMinorUnits(cur string, units int64) (Money, error) {
+	return FromScaled(cur, units, -1)
+}
+
+// FromScaled returns a new Money for the given currency and an amount of units×10^-exp,
+// e.g. FromScaled("GBP", 1234500, 4) is £123.45.
+// If exp is negative, the currency's exponent is used.
+// It returns an error if cur is not well formed or not recognised, if exp is greater than 18,
+// or if the amount can't be represented exactly in the currency's minor units.
+func FromScaled(cur string, units int64, exp int) (Money, error) {
+	c, err := currency.ParseISO(cur)
 	if err != nil {
-		return 0, fmt.Errorf("unable to parse amount: %v", err)
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if exp > maxCurrencyExponent {
+		return Money{}, fmt.Errorf("exponent must be between 0 and %d, got %d", maxCurrencyExponent, exp)
+	}
+	x := Money{c: c}
+	if units == 0 {
+		return x, nil
+	}
+	if exp < 0 {
+		exp = x.Exponent()
+	}
+	for ; exp < x.Exponent(); exp++ {
+		if units > math.MaxInt64/10 || units < math.MinInt64/10 {
+			return Money{}, fmt.Errorf("%w: %de-%d %s", ErrOutOfRange, units, exp, cur)
+		}
+		units *= 10
+	}
+	for ; exp > x.Exponent(); exp-- {
+		if units%10 != 0 {
+			return Money{}, fmt.Errorf("amount %de-%d can't be represented exactly in %s", units, exp, cur)
+		}
+		units /= 10
+	}
+	if int64(int(units)) != units || units == math.MinInt64 {
+		return Money{}, fmt.Errorf("%w: %d %s minor units", ErrOutOfRange, units, cur)
+	}
+	x.a = int(units)
+	return x, nil
+}
+
+func strToInt(c currency.Unit, amt string) (int, error) {
+	// Hand-rolled equivalent of ^(-)?(\d+)(\.(\d{exp}))?$, as parsing is on the hot path.
+	s := amt
+	neg := len(s) > 0 && s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	a, n, ok := scanDigits(0, s)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrOutOfRange, amt)
+	}
+	if n == 0 {
+		return 0, badAmount(amt)
 	}
-	if m[1] == "-" {
+	s = s[n:]
+	exp := exponent(c)
+	min := zeros[:exp]
+	if len(s) > 0 {
+		if exp == 0 || len(s) != exp+1 || s[0] != '.' {
+			return 0, badAmount(amt)
+		}
+		min = s[1:]
+	}
+	a, n, ok = scanDigits(a, min)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrOutOfRange, amt)
+	}
+	if n != len(min) {
+		return 0, badAmount(amt)
+	}
+	if neg {
 		a *= -1
 	}
 	return a, nil
 }
 
+// scanDigits accumulates the leading decimal digits of s onto a.
+// It returns the new value and the number of digits consumed.
+// ok is false if the value would overflow.
+func scanDigits(a int, s string) (v int, n int, ok bool) {
+	for n < len(s) && '0' <= s[n] && s[n] <= '9' {
+		d := int(s[n] - '0')
+		if a > (math.MaxInt-d)/10 {
+			return 0, n, false
+		}
+		a = a*10 + d
+		n++
+	}
+	return a, n, true
+}
+
 // Currency gets the currency of the Money.
 func (x Money) Currency() string {
 	return x.c.String()
 }
 
+// MinorUnits gets the amount of the Money in the smallest unit of its currency,
+// e.g. 12345 for £123.45.
+func (x Money) MinorUnits() int64 {
+	return int64(x.a)
+}
+
+// Exponent gets the number of digits after the decimal point in the Money's amount,
+// i.e. there are 10^Exponent minor units in a major unit.
+// This is the ISO 4217 minor unit of the currency, e.g. 2 for GBP, 0 for JPY.
+func (x Money) Exponent() int {
+	return exponent(x.c)
+}
+
 // Amount gets the currency of the Money.
 func (x Money) Amount() string {
-	neg := ""
-	a := x.a
-	if a < 0 {
-		neg = "-"
-		a *= -1
+	return string(x.AppendAmount(make([]byte, 0, 24)))
+}
+
+// AppendAmount appends the amount of the Money, as returned by Amount, to dst
+// and returns the extended buffer.
+func (x Money) AppendAmount(dst []byte) []byte {
+	u := uint(x.a)
+	if x.a < 0 {
+		dst = append(dst, '-')
+		u = -u
+	}
+	exp := x.Exponent()
+	if exp == 0 {
+		return strconv.AppendUint(dst, uint64(u), 10)
 	}
-	maj := strconv.Itoa(a / 100) // TODO: Variable
-	min := fmt.Sprintf("%02d", a%100)
+	dst = strconv.AppendUint(dst, uint64(u)/pow10[exp], 10)
+	dst = append(dst, '.')
+	dst = append(dst, zeros[:exp]...)
+	for i, min := len(dst)-1, uint64(u)%pow10[exp]; min > 0; i, min = i-1, min/10 {
+		dst[i] = byte('0' + min%10)
+	}
+	return dst
+}
+
+// String returns the currency and amount of the Money, e.g. "GBP 123.45".
+func (x Money) String() string {
+	return string(x.AppendString(make([]byte, 0, 28)))
+}
 
-	return neg + maj + "." + min
+// AppendString appends the string form of the Money, as returned by String, to dst
+// and returns the extended buffer.
+func (x Money) AppendString(dst []byte) []byte {
+	dst = append(dst, x.Currency()...)
+	dst = append(dst, ' ')
+	return x.AppendAmount(dst)
 }
 
 // Add returns a new Money with the value of the given Money added.
+// It returns an error if the currencies differ, or one wrapping ErrOutOfRange if the result would overflow.
 func (x Money) Add(y Money) (Money, error) {
 	return addSub(x, y, true)
 }
 
 // Sub returns a new Money with the value of the given Money added.
+// It returns an error if the currencies differ, or one wrapping ErrOutOfRange if the result would overflow.
 func (x Money) Sub(y Money) (Money, error) {
 	return addSub(x, y, false)
 }
@@ -97,11 +238,13 @@ func addSub(x, y Money, add bool) (Money, error) {
 		err := fmt.Errorf("Can't %s different currencies. Attempting to add %s and %s", op, x.Currency(), y.Currency())
 		return Money{}, err
 	}
-	var z int
-	if add {
-		z = x.a + y.a
-	} else {
-		z = x.a - y.a
+	b := y.a
+	if !add {
+		b = -b
+	}
+	z, ok := addAtoms(x.a, b)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %v + %v", ErrOutOfRange, x, Money{y.c, b})
 	}
 	return Money{
 		x.c,
@@ -110,10 +253,15 @@ func addSub(x, y Money, add bool) (Money, error) {
 }
 
 // Mul returns a new Money with the value of m multiplied by factor.
+// It returns an error wrapping ErrOutOfRange if the result would overflow.
 func (x Money) Mul(f int) (Money, error) {
+	z, ok := mulAtoms(x.a, f)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %v × %d", ErrOutOfRange, x, f)
+	}
 	return Money{
 		x.c,
-		x.a * f,
+		z,
 	}, nil
 }
 
@@ -141,28 +289,26 @@ func (x Money) Cmp(y Money) (c int, err error) {
 // Spare pennies are distributed among parties evenly, from first to last.
 func (x Money) Share(weightings []uint) []Money {
 	n := len(weightings)
-	var sum uint
+	sum := new(big.Int)
 	for _, w := range weightings {
-		sum += w
+		sum.Add(sum, new(big.Int).SetUint64(uint64(w)))
 	}
-	if sum == 0 {
+	if sum.Sign() == 0 {
 		for i := range weightings {
 			weightings[i] = 1
 		}
-		sum = uint(n)
-	}
-	ratios := make([]float64, n)
-	for i := range weightings {
-		ratios[i] = float64(weightings[i]) / float64(sum)
+		sum.SetInt64(int64(n))
 	}
 
+	// Each portion is the exact share rounded towards zero. Floating point isn't precise
+	// enough for this with large amounts.
 	allocations := make([]int, n)
-	fa := float64(x.a)
 	rem := x.a
-	for i := range ratios {
-		a := int(math.Trunc(ratios[i] * fa))
-		allocations[i] = a
-		rem -= a
+	for i, w := range weightings {
+		a := new(big.Int).Mul(big.NewInt(int64(x.a)), new(big.Int).SetUint64(uint64(w)))
+		a.Quo(a, sum)
+		allocations[i] = int(a.Int64())
+		rem -= allocations[i]
 	}
 	d := 1
 	if rem < 0 {
@@ -0,0 +1,356 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// Money128 is like Money, but holds up to 34 significant digits, the precision of IEEE 754 decimal128,
+// rather than an int's worth of minor units. It is for integrations with databases and message formats
+// that are decimal128-native, whose amounts may be beyond Money's range. It has Money's core API:
+// parsing, formatting, Add, Sub, Mul, Cmp and Share. It converts exactly to and from Decimal128,
+// converts to Money for anything else, e.g. rounding, and implements Monetary.
+// The zero value is XXX 0, like Money's, and Money128 values are comparable with ==.
+type Money128 struct {
+	c currency.Unit
+	// neg, hi and lo hold the amount in minor units as a sign and a 128-bit magnitude.
+	// Zero is never negative, so that equal amounts are ==.
+	neg    bool
+	hi, lo uint64
+}
+
+var _ Monetary = Money128{}
+
+// maxMoney128Digits is the number of significant digits a Money128 can hold.
+const maxMoney128Digits = 34
+
+// New128 is like New, but returns a Money128, e.g. New128("GBP", "12345678901234567890123.45").
+// It returns an error if cur is not well formed or not recognised, if amt cannot be parsed,
+// or one wrapping ErrOutOfRange if amt has more than 34 digits.
+func New128(cur, amt string) (Money128, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money128{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	neg := strings.HasPrefix(amt, "-")
+	whole, frac, hasPoint := strings.Cut(strings.TrimPrefix(amt, "-"), ".")
+	exp := exponent(c)
+	ok := whole != "" && strings.Trim(whole, "0123456789") == ""
+	if hasPoint {
+		ok = ok && exp > 0 && len(frac) == exp && strings.Trim(frac, "0123456789") == ""
+	} else {
+		frac = zeros[:exp]
+	}
+	if !ok {
+		return Money128{}, badAmount(amt)
+	}
+	a, _ := new(big.Int).SetString(whole+frac, 10)
+	if neg {
+		a.Neg(a)
+	}
+	return money128(c, a)
+}
+
+// Parse128 is like Parse, but returns a Money128.
+func Parse128(s string) (Money128, error) {
+	f := strings.Fields(s)
+	if len(f) != 2 {
+		return Money128{}, fmt.Errorf("couldn't parse money: expected currency and amount, e.g. \"GBP 123.45\", got %q", s)
+	}
+	return New128(f[0], f[1])
+}
+
+// Money128Of returns x as a Money128.
+func Money128Of(x Money) Money128 {
+	z, _ := money128(x.c, big.NewInt(int64(x.a)))
+	return z
+}
+
+// money128 returns the Money128 in currency c of a minor units.
+// It returns an error wrapping ErrOutOfRange if a has more than 34 digits.
+func money128(c currency.Unit, a *big.Int) (Money128, error) {
+	if a.CmpAbs(maxDecimal128Coeff) > 0 {
+		return Money128{}, fmt.Errorf("%w: %s %s minor units", ErrOutOfRange, a, c)
+	}
+	var m big.Int
+	m.Abs(a)
+	lo := new(big.Int).And(&m, new(big.Int).SetUint64(math.MaxUint64))
+	return Money128{
+		c:   c,
+		neg: a.Sign() < 0,
+		hi:  m.Rsh(&m, 64).Uint64(),
+		lo:  lo.Uint64(),
+	}, nil
+}
+
+// units returns the amount in minor units.
+func (x Money128) units() *big.Int {
+	a := new(big.Int).SetUint64(x.hi)
+	a.Lsh(a, 64)
+	a.Or(a, new(big.Int).SetUint64(x.lo))
+	if x.neg {
+		a.Neg(a)
+	}
+	return a
+}
+
+// Money returns x as a Money.
+// It returns an error wrapping ErrOutOfRange if x is beyond Money's range.
+func (x Money128) Money() (Money, error) {
+	a := x.units()
+	if !a.IsInt64() || a.Int64() == math.MinInt64 || int64(int(a.Int64())) != a.Int64() {
+		return Money{}, fmt.Errorf("%w: %v", ErrOutOfRange, x)
+	}
+	return Money{x.c, int(a.Int64())}, nil
+}
+
+// Currency gets the currency of the Money128.
+func (x Money128) Currency() string {
+	return x.c.String()
+}
+
+// Exponent gets the number of digits after the decimal point in the amount, as for Money.
+func (x Money128) Exponent() int {
+	return exponent(x.c)
+}
+
+// MinorUnits gets the amount in minor units, as for Money. Amounts beyond the range of int64 are clamped
+// to math.MinInt64 or math.MaxInt64; use Rat or Decimal128 for the exact amount.
+func (x Money128) MinorUnits() int64 {
+	a := x.units()
+	switch {
+	case a.IsInt64():
+		return a.Int64()
+	case x.neg:
+		return math.MinInt64
+	default:
+		return math.MaxInt64
+	}
+}
+
+// Rat returns the exact amount in major units.
+func (x Money128) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(x.units(), new(big.Int).SetUint64(pow10[x.Exponent()]))
+}
+
+// Amount gets the amount as a decimal string, as for Money, e.g. "12345678901234567890123.45".
+func (x Money128) Amount() string {
+	return string(x.AppendAmount(nil))
+}
+
+// AppendAmount appends the amount, as returned by Amount, to dst and returns the extended buffer.
+func (x Money128) AppendAmount(dst []byte) []byte {
+	if x.neg {
+		dst = append(dst, '-')
+	}
+	exp := x.Exponent()
+	s := new(big.Int).Abs(x.units()).String()
+	if len(s) <= exp {
+		s = zeros[:exp+1-len(s)] + s
+	}
+	dst = append(dst, s[:len(s)-exp]...)
+	if exp > 0 {
+		dst = append(dst, '.')
+		dst = append(dst, s[len(s)-exp:]...)
+	}
+	return dst
+}
+
+// String returns the currency and amount, as for Money, e.g. "GBP 123.45".
+func (x Money128) String() string {
+	return x.Currency() + " " + x.Amount()
+}
+
+// MarshalText implements encoding.TextMarshaler, using the form returned by String.
+func (x Money128) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the form accepted by Parse128.
+func (x *Money128) UnmarshalText(text []byte) error {
+	m, err := Parse128(string(text))
+	if err != nil {
+		return err
+	}
+	*x = m
+	return nil
+}
+
+// Add returns the sum of x and y.
+// It returns an error if the currencies differ, or one wrapping ErrOutOfRange if the result would overflow.
+func (x Money128) Add(y Money128) (Money128, error) {
+	if x.c != y.c {
+		return Money128{}, fmt.Errorf("Can't add different currencies. Attempting to add %s and %s", x.Currency(), y.Currency())
+	}
+	return money128(x.c, new(big.Int).Add(x.units(), y.units()))
+}
+
+// Sub returns x less y.
+// It returns an error if the currencies differ, or one wrapping ErrOutOfRange if the result would overflow.
+func (x Money128) Sub(y Money128) (Money128, error) {
+	if x.c != y.c {
+		return Money128{}, fmt.Errorf("Can't subtract different currencies. Attempting to subtract %s and %s", x.Currency(), y.Currency())
+	}
+	return money128(x.c, new(big.Int).Sub(x.units(), y.units()))
+}
+
+// Mul returns x multiplied by f.
+// It returns an error wrapping ErrOutOfRange if the result would overflow.
+func (x Money128) Mul(f int) (Money128, error) {
+	return money128(x.c, new(big.Int).Mul(x.units(), big.NewInt(int64(f))))
+}
+
+// Cmp compares x and y, as for Money.
+// It returns an error if the currencies differ.
+func (x Money128) Cmp(y Money128) (int, error) {
+	if x.c != y.c {
+		return 0, fmt.Errorf("Can't compare different currencies (%s and %s)", x.Currency(), y.Currency())
+	}
+	return x.units().Cmp(y.units()), nil
+}
+
+// Share allocates portions of x between parties based on the weightings given, as for Money.
+// Spare minor units are distributed among parties evenly, from first to last.
+// It returns an empty slice if there are no weightings.
+func (x Money128) Share(weightings []uint) []Money128 {
+	n := len(weightings)
+	if n == 0 {
+		return []Money128{}
+	}
+	ws := make([]*big.Int, n)
+	sum := new(big.Int)
+	for i, w := range weightings {
+		ws[i] = new(big.Int).SetUint64(uint64(w))
+		sum.Add(sum, ws[i])
+	}
+	if sum.Sign() == 0 {
+		for i := range ws {
+			ws[i].SetInt64(1)
+		}
+		sum.SetInt64(int64(n))
+	}
+	a := x.units()
+	portions := make([]*big.Int, n)
+	rem := new(big.Int).Set(a)
+	for i, w := range ws {
+		portions[i] = new(big.Int).Mul(a, w)
+		portions[i].Quo(portions[i], sum)
+		rem.Sub(rem, portions[i])
+	}
+	d := big.NewInt(int64(rem.Sign()))
+	for i := 0; rem.Sign() != 0; i++ {
+		if ws[i%n].Sign() == 0 {
+			continue
+		}
+		portions[i%n].Add(portions[i%n], d)
+		rem.Sub(rem, d)
+	}
+	res := make([]Money128, n)
+	for i, p := range portions {
+		// No portion is larger in magnitude than x, so this can't fail.
+		res[i], _ = money128(x.c, p)
+	}
+	return res
+}
+
+// Decimal128 returns x as a Decimal128, with the currency's exponent.
+func (x Money128) Decimal128() Decimal128 {
+	var sign uint64
+	if x.neg {
+		sign = decimal128SignBit
+	}
+	return Decimal128{
+		Hi: sign | uint64(decimal128Bias-x.Exponent())<<49 | x.hi,
+		Lo: x.lo,
+	}
+}
+
+// Money128FromDecimal128 is like FromDecimal128, but returns a Money128, so that any amount with
+// up to 34 digits in minor units can be read.
+func Money128FromDecimal128(cur string, d Decimal128) (Money128, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money128{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	coeff, q, err := d.parts()
+	if err != nil {
+		return Money128{}, err
+	}
+	if coeff.Sign() == 0 {
+		return Money128{c: c}, nil
+	}
+	// Shift to minor units, bailing out early on exponents that can't possibly fit.
+	q += exponent(c)
+	switch {
+	case q >= maxMoney128Digits:
+		return Money128{}, fmt.Errorf("%w: decimal128 %s", ErrOutOfRange, d)
+	case q <= -maxMoney128Digits:
+		return Money128{}, fmt.Errorf("decimal128 %s can't be represented exactly in %s", d, cur)
+	}
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(q))), nil)
+	if q >= 0 {
+		return money128(c, coeff.Mul(coeff, p))
+	}
+	var r big.Int
+	if coeff.QuoRem(coeff, p, &r); r.Sign() != 0 {
+		return Money128{}, fmt.Errorf("decimal128 %s can't be represented exactly in %s", d, cur)
+	}
+	return money128(c, coeff)
+}
+
+// toMoney128 returns m as a Money128.
+// It returns an error if m's currency isn't recognised, or its amount can't be represented exactly.
+func toMoney128(m Monetary) (Money128, error) {
+	switch y := m.(type) {
+	case Money128:
+		return y, nil
+	case Money:
+		return Money128Of(y), nil
+	}
+	c, err := currency.ParseISO(m.Currency())
+	if err != nil {
+		return Money128{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	r := m.Rat()
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).SetUint64(pow10[exponent(c)])))
+	if !r.IsInt() {
+		return Money128{}, fmt.Errorf("%s %s can't be represented exactly in %s", m.Currency(), m.Amount(), c)
+	}
+	return money128(c, r.Num())
+}
+
+// AddMonetary implements Monetary. It is like Add, but y may be any Monetary.
+func (x Money128) AddMonetary(y Monetary) (Monetary, error) {
+	z, err := toMoney128(y)
+	if err == nil {
+		z, err = x.Add(z)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SubMonetary implements Monetary. It is like Sub, but y may be any Monetary.
+func (x Money128) SubMonetary(y Monetary) (Monetary, error) {
+	z, err := toMoney128(y)
+	if err == nil {
+		z, err = x.Sub(z)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// CmpMonetary implements Monetary. It is like Cmp, but y may be any Monetary, which is compared exactly.
+func (x Money128) CmpMonetary(y Monetary) (int, error) {
+	if x.Currency() != y.Currency() {
+		return 0, fmt.Errorf("Can't compare different currencies (%s and %s)", x.Currency(), y.Currency())
+	}
+	return x.Rat().Cmp(y.Rat()), nil
+}
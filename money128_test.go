@@ -0,0 +1,220 @@
+package dough
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCanCreateMoney128(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "1.23", "GBP 1.23"},
+		{"GBP", "-0.05", "GBP -0.05"},
+		{"GBP", "-0.00", "GBP 0.00"},
+		{"JPY", "500", "JPY 500"},
+		{"KWD", "1.234", "KWD 1.234"},
+		{"GBP", "12", "GBP 12.00"},
+		{"GBP", "92233720368547758.08", "GBP 92233720368547758.08"},
+		{"GBP", "99999999999999999999999999999999.99", "GBP 99999999999999999999999999999999.99"},
+		{"GBP", "-99999999999999999999999999999999.99", "GBP -99999999999999999999999999999999.99"},
+	}
+	for _, c := range cases {
+		got, err := New128(c.cur, c.amt)
+		if err != nil || got.String() != c.want {
+			t.Errorf("New128(%s, %s): wanted %s, got %v (%v)", c.cur, c.amt, c.want, got, err)
+		}
+		back, err := Parse128(got.String())
+		if err != nil || back != got {
+			t.Errorf("Parse128(%q): wanted %v, got %v (%v)", got.String(), got, back, err)
+		}
+	}
+}
+
+func TestCanRejectBadMoney128(t *testing.T) {
+	var cases = []struct {
+		cur      string
+		amt      string
+		outRange bool
+	}{
+		{"FOO", "1.00", false},
+		{"GBP", "1.2", false},
+		{"GBP", "1.234", false},
+		{"GBP", ".23", false},
+		{"GBP", "1e3", false},
+		{"GBP", "", false},
+		{"JPY", "1.00", false},
+		{"GBP", "100000000000000000000000000000000.00", true},
+	}
+	for _, c := range cases {
+		_, err := New128(c.cur, c.amt)
+		if err == nil || errors.Is(err, ErrOutOfRange) != c.outRange {
+			t.Errorf("New128(%s, %s): wanted error (out of range %t), got %v", c.cur, c.amt, c.outRange, err)
+		}
+	}
+}
+
+func TestCanDoArithmeticWithMoney128(t *testing.T) {
+	big, _ := New128("GBP", "99999999999999999999999999999999.99")
+	x, _ := New128("GBP", "92233720368547758.07")
+	one, _ := New128("GBP", "0.01")
+	if got, err := x.Add(one); err != nil || got.Amount() != "92233720368547758.08" {
+		t.Errorf("%v.Add(%v): wanted 92233720368547758.08, got %v (%v)", x, one, got, err)
+	}
+	if got, err := one.Sub(x); err != nil || got.Amount() != "-92233720368547758.06" {
+		t.Errorf("%v.Sub(%v): wanted -92233720368547758.06, got %v (%v)", one, x, got, err)
+	}
+	if got, err := x.Mul(-1000); err != nil || got.Amount() != "-92233720368547758070.00" {
+		t.Errorf("%v.Mul(-1000): wanted -92233720368547758070.00, got %v (%v)", x, got, err)
+	}
+	if got, err := x.Cmp(big); err != nil || got != -1 {
+		t.Errorf("%v.Cmp(%v): wanted -1, got %d (%v)", x, big, got, err)
+	}
+	if _, err := big.Add(one); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("%v.Add(%v): wanted ErrOutOfRange, got %v", big, one, err)
+	}
+	if _, err := big.Mul(2); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("%v.Mul(2): wanted ErrOutOfRange, got %v", big, err)
+	}
+	usd, _ := New128("USD", "1.00")
+	if _, err := x.Add(usd); err == nil || !strings.Contains(err.Error(), "add") {
+		t.Errorf("%v.Add(%v): wanted error about adding, got %v", x, usd, err)
+	}
+	if _, err := x.Sub(usd); err == nil || strings.Contains(err.Error(), "add") {
+		t.Errorf("%v.Sub(%v): wanted error about subtracting, got %v", x, usd, err)
+	}
+	if _, err := x.Cmp(usd); err == nil {
+		t.Errorf("%v.Cmp(%v): wanted error", x, usd)
+	}
+}
+
+func TestCanAllocateMoney128(t *testing.T) {
+	var cases = []struct {
+		amt        string
+		weightings []uint
+		want       []string
+	}{
+		{"1.00", []uint{1, 1, 1}, []string{"0.34", "0.33", "0.33"}},
+		{"-1.00", []uint{1, 1, 1}, []string{"-0.34", "-0.33", "-0.33"}},
+		{"0.05", []uint{0, 1, 1}, []string{"0.00", "0.03", "0.02"}},
+		{"0.05", []uint{0, 0}, []string{"0.03", "0.02"}},
+		{"99999999999999999999999999999999.99", []uint{1, 2}, []string{"33333333333333333333333333333333.33", "66666666666666666666666666666666.66"}},
+		{"1.00", nil, []string{}},
+		{"1.00", []uint{}, []string{}},
+	}
+	for _, c := range cases {
+		x, _ := New128("GBP", c.amt)
+		got := x.Share(c.weightings)
+		if len(got) != len(c.want) {
+			t.Errorf("%v.Share(%v): wanted %v, got %v", x, c.weightings, c.want, got)
+			continue
+		}
+		for i := range c.want {
+			if got[i].Amount() != c.want[i] {
+				t.Errorf("%v.Share(%v): wanted %v, got %v", x, c.weightings, c.want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestCanConvertMoney128(t *testing.T) {
+	x := MustNew("GBP", "-1.23")
+	y := Money128Of(x)
+	if y.String() != "GBP -1.23" || y.MinorUnits() != -123 || y.Exponent() != 2 {
+		t.Errorf("Money128Of(%v): got %v", x, y)
+	}
+	if back, err := y.Money(); err != nil || back != x {
+		t.Errorf("%v.Money(): wanted %v, got %v (%v)", y, x, back, err)
+	}
+	if y.Decimal128() != x.Decimal128() {
+		t.Errorf("%v.Decimal128(): wanted %v, got %v", y, x.Decimal128(), y.Decimal128())
+	}
+	big, _ := New128("GBP", "-99999999999999999999999999999999.99")
+	if _, err := big.Money(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("%v.Money(): wanted ErrOutOfRange, got %v", big, err)
+	}
+	if _, err := ToMoney(big); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ToMoney(%v): wanted ErrOutOfRange, got %v", big, err)
+	}
+	if big.MinorUnits() != -1<<63 {
+		t.Errorf("%v.MinorUnits(): wanted clamped, got %d", big, big.MinorUnits())
+	}
+	var z Money128
+	if err := z.UnmarshalText([]byte(big.String())); err != nil || z != big {
+		t.Errorf("UnmarshalText(%q): wanted %v, got %v (%v)", big.String(), big, z, err)
+	}
+}
+
+func TestCanConvertMoney128ToAndFromDecimal128(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		d    Decimal128
+		want string
+	}{
+		{"GBP", Decimal128{0x303c000000000000, 100}, "1.00"},
+		{"GBP", Decimal128{0xb03c000000000000, 123}, "-1.23"},
+		{"GBP", Decimal128{0x303e000000000000, 12}, "1.20"},
+		{"JPY", Decimal128{0x3042000000000000, 5}, "50"},
+		{"GBP", Decimal128{0x303ded09bead87c0, 0x378d8e63ffffffff}, "99999999999999999999999999999999.99"},
+		{"GBP", Decimal128{0xb03c000000000000, 0}, "0.00"},
+	}
+	for _, c := range cases {
+		got, err := Money128FromDecimal128(c.cur, c.d)
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("Money128FromDecimal128(%s, %v): wanted %s, got %v (%v)", c.cur, c.d, c.want, got, err)
+			continue
+		}
+		back, err := Money128FromDecimal128(c.cur, got.Decimal128())
+		if err != nil || back != got {
+			t.Errorf("Money128FromDecimal128(%s, %v): wanted %v, got %v (%v)", c.cur, got.Decimal128(), got, back, err)
+		}
+	}
+}
+
+func TestCanRejectBadDecimal128ForMoney128(t *testing.T) {
+	var cases = []struct {
+		cur      string
+		d        Decimal128
+		outRange bool
+	}{
+		{"FOO", Decimal128{0x303c000000000000, 100}, false},
+		{"GBP", Decimal128{0x7800000000000000, 0}, false},
+		{"GBP", Decimal128{0x303a000000000000, 1}, false},
+		{"GBP", Decimal128{0x303fed09bead87c0, 0x378d8e63ffffffff}, true},
+		{"GBP", Decimal128{0x5ffe000000000000, 1}, true},
+	}
+	for _, c := range cases {
+		_, err := Money128FromDecimal128(c.cur, c.d)
+		if err == nil || errors.Is(err, ErrOutOfRange) != c.outRange {
+			t.Errorf("Money128FromDecimal128(%s, %v): wanted error (out of range %t), got %v", c.cur, c.d, c.outRange, err)
+		}
+	}
+}
+
+func TestCanUseMoney128AsMonetary(t *testing.T) {
+	x, _ := New128("GBP", "92233720368547758.07")
+	sum, err := x.AddMonetary(MustNew("GBP", "0.01"))
+	if err != nil || sum.Amount() != "92233720368547758.08" {
+		t.Errorf("%v.AddMonetary(GBP 0.01): wanted 92233720368547758.08, got %v (%v)", x, sum, err)
+	}
+	diff, err := x.SubMonetary(sum)
+	if err != nil || diff.Amount() != "-0.01" {
+		t.Errorf("%v.SubMonetary(%v): wanted -0.01, got %v (%v)", x, sum, diff, err)
+	}
+	if c, err := x.CmpMonetary(MustNew("GBP", "1.00")); err != nil || c != 1 {
+		t.Errorf("%v.CmpMonetary(GBP 1.00): wanted 1, got %d (%v)", x, c, err)
+	}
+	if _, err := x.AddMonetary(MustNew("USD", "1.00")); err == nil {
+		t.Errorf("%v.AddMonetary(USD 1.00): wanted error", x)
+	}
+	if _, err := x.CmpMonetary(MustNew("USD", "1.00")); err == nil {
+		t.Errorf("%v.CmpMonetary(USD 1.00): wanted error", x)
+	}
+	if got, err := totalOf(x, MustNew("GBP", "0.01")); err != nil || got.Amount() != "92233720368547758.08" {
+		t.Errorf("totalOf: wanted 92233720368547758.08, got %v (%v)", got, err)
+	}
+}
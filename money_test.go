@@ -1,6 +1,9 @@
 package dough
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestCanCreate(t *testing.T) {
 	var cases = []struct {
@@ -15,6 +18,13 @@ func TestCanCreate(t *testing.T) {
 		{"AUD", "0.01"},
 		{"AUD", "-0.01"},
 		{"AUD", "123.45"},
+		{"JPY", "0"},
+		{"JPY", "-1"},
+		{"JPY", "12345"},
+		{"BHD", "0.000"},
+		{"BHD", "-0.001"},
+		{"BHD", "123.456"},
+		{"CLF", "12.3456"},
 	}
 	for _, c := range cases {
 		sut, err := New(c.cur, c.amt)
@@ -56,6 +66,16 @@ func TestCanRejectBadAmount(t *testing.T) {
 		{"ONE"},
 		{"10 EUR"},
 		{"1f.00"},
+		{""},
+		{"-"},
+		{".12"},
+		{"12."},
+		{"12.3"},
+		{"12.345"},
+		{"+12.34"},
+		{"--12.34"},
+		{"12.34-"},
+		{"99999999999999999999.00"},
 	}
 	for _, c := range cases {
 		_, err := New("GBP", c.amt)
@@ -65,6 +85,102 @@ func TestCanRejectBadAmount(t *testing.T) {
 	}
 }
 
+func TestCanMustNew(t *testing.T) {
+	if got := MustNew("GBP", "1.23"); got.String() != "GBP 1.23" {
+		t.Errorf("MustNew(GBP, 1.23): wanted GBP 1.23, got %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("panic expected from MustNew(GBP, 1.2), none received")
+		}
+	}()
+	MustNew("GBP", "1.2")
+}
+
+func TestCanRejectAmountWithWrongExponent(t *testing.T) {
+	var cases = []struct {
+		cur string
+		amt string
+	}{
+		{"JPY", "1.00"},
+		{"JPY", "1."},
+		{"JPY", "1.0"},
+		{"BHD", "1.23"},
+		{"BHD", "1.2345"},
+		{"GBP", "1.234"},
+	}
+	for _, c := range cases {
+		if _, err := New(c.cur, c.amt); err == nil {
+			t.Errorf("error expected from New(\"%s\",\"%s\"), none received", c.cur, c.amt)
+		}
+	}
+}
+
+func TestCanGetExponent(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want int
+		mu   int64
+	}{
+		{"GBP", "1", 2, 100},
+		{"JPY", "1", 0, 1},
+		{"KWD", "1", 3, 1000},
+		{"CLF", "1", 4, 10000},
+		{"XAU", "1", 4, 10000},
+		{"XDR", "1", 2, 100},
+	}
+	for _, c := range cases {
+		sut, _ := New(c.cur, c.amt)
+		if got := sut.Exponent(); got != c.want {
+			t.Errorf("exponent of %s: wanted %d, got %d", c.cur, c.want, got)
+		}
+		if got := sut.MinorUnits(); got != c.mu {
+			t.Errorf("minor units of %s %s: wanted %d, got %d", c.cur, c.amt, c.mu, got)
+		}
+	}
+}
+
+func TestCanSetExponent(t *testing.T) {
+	defer SetExponent("XDR", 2)
+	if err := SetExponent("XDR", 6); err != nil {
+		t.Fatalf("error received from SetExponent(XDR, 6), none expected %v", err)
+	}
+	x, err := New("XDR", "1.234567")
+	if err != nil || x.Exponent() != 6 || x.MinorUnits() != 1234567 {
+		t.Errorf("New(XDR, 1.234567): wanted 1234567 minor units with exponent 6, got %v (%v)", x, err)
+	}
+	if gold, _ := XAU("0.0001"); gold.MinorUnits() != 1 {
+		t.Errorf("wanted XAU unaffected, got %d minor units", gold.MinorUnits())
+	}
+	var cases = []struct {
+		cur string
+		exp int
+	}{
+		{"GBP", 4},
+		{"FOO", 4},
+		{"XAU", -1},
+		{"XAU", 19},
+	}
+	for _, c := range cases {
+		if err := SetExponent(c.cur, c.exp); err == nil {
+			t.Errorf("error expected from SetExponent(%s, %d), none received", c.cur, c.exp)
+		}
+	}
+}
+
+func TestCanUseTestAndNoCurrencyCodes(t *testing.T) {
+	for _, cur := range []string{"XTS", "XXX"} {
+		x, err := New(cur, "12.34")
+		if err != nil || x.Exponent() != 2 || x.String() != cur+" 12.34" {
+			t.Errorf("New(%s, 12.34): wanted %s 12.34 with exponent 2, got %v, %d (%v)", cur, cur, x, x.Exponent(), err)
+		}
+	}
+	if got := (Money{}).String(); got != "XXX 0.00" {
+		t.Errorf("wanted zero Money to be XXX 0.00, got %s", got)
+	}
+}
+
 func TestCanAdd(t *testing.T) {
 	var cases = []struct {
 		a    string
@@ -262,6 +378,9 @@ func TestCanAllocate(t *testing.T) {
 		{"-120.00", []uint{20, 100}, []string{"-20.00", "-100.00"}},
 		{"-300.00", []uint{0}, []string{"-300.00"}},
 		{"-300.00", []uint{0, 0, 0}, []string{"-100.00", "-100.00", "-100.00"}},
+
+		// Amounts too large to share exactly in floating point.
+		{"92233720368547758.07", []uint{65535, 1, 2}, []string{"92229498372742185.07", "1407331935191.00", "2814663870382.00"}},
 	}
 	for ci, c := range cases {
 		a, _ := New("GBP", c.a)
@@ -277,3 +396,118 @@ func TestCanAllocate(t *testing.T) {
 		}
 	}
 }
+
+func TestCanFormat(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "0.00", "GBP 0.00"},
+		{"GBP", "-0.01", "GBP -0.01"},
+		{"EUR", "123.45", "EUR 123.45"},
+		{"AUD", "-1000000.10", "AUD -1000000.10"},
+	}
+	for _, c := range cases {
+		sut, _ := New(c.cur, c.amt)
+		if got := sut.String(); got != c.want {
+			t.Errorf("wanted %s, got %s", c.want, got)
+		}
+		if got := string(sut.AppendAmount([]byte("x="))); got != "x="+c.amt {
+			t.Errorf("wanted x=%s, got %s", c.amt, got)
+		}
+		if got := string(sut.AppendString([]byte("x="))); got != "x="+c.want {
+			t.Errorf("wanted x=%s, got %s", c.want, got)
+		}
+	}
+}
+
+func TestCanFormatWithoutAllocating(t *testing.T) {
+	m, _ := New("GBP", "-12345.67")
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() {
+		buf = m.AppendString(buf[:0])
+	}); n != 0 {
+		t.Errorf("wanted 0 allocations, got %v", n)
+	}
+}
+
+func TestCanCreateFromMinorUnits(t *testing.T) {
+	var cases = []struct {
+		cur   string
+		units int64
+		exp   int
+		want  string
+	}{
+		{"GBP", 12345, -1, "123.45"},
+		{"GBP", -1, -1, "-0.01"},
+		{"GBP", 12345, 2, "123.45"},
+		{"GBP", 1234500, 4, "123.45"},
+		{"GBP", 123, 0, "123.00"},
+		{"GBP", -1234, 1, "-123.40"},
+		// Minor units are the currency's own, and two-decimal amounts stored before ISO 4217
+		// minor units were adopted can be rescaled exactly.
+		{"JPY", 10000, -1, "10000"},
+		{"JPY", 10000, 2, "100"},
+		{"KWD", 1234, -1, "1.234"},
+		{"KWD", 1234, 2, "12.340"},
+	}
+	for _, c := range cases {
+		sut, err := FromScaled(c.cur, c.units, c.exp)
+		if err != nil {
+			t.Errorf("error received from FromScaled(%s, %d, %d), none expected %v", c.cur, c.units, c.exp, err)
+		}
+		if sut.Amount() != c.want {
+			t.Errorf("FromScaled(%s, %d, %d): wanted %s, got %s", c.cur, c.units, c.exp, c.want, sut.Amount())
+		}
+		if c.exp < 0 {
+			if m, _ := FromMinorUnits(c.cur, c.units); m != sut || m.MinorUnits() != c.units {
+				t.Errorf("FromMinorUnits(%s, %d): wanted %v, got %v", c.cur, c.units, sut, m)
+			}
+		}
+	}
+}
+
+func TestCanRejectBadMinorUnits(t *testing.T) {
+	var cases = []struct {
+		cur   string
+		units int64
+		exp   int
+	}{
+		{"FOO", 1, 2},
+		{"GBP", 12345, 3},
+		{"GBP", 1, 4},
+		{"GBP", math.MaxInt64, 0},
+		{"GBP", 1, 19},
+		{"GBP", 0, 1 << 40},
+		{"GBP", 1, math.MaxInt},
+	}
+	for _, c := range cases {
+		if _, err := FromScaled(c.cur, c.units, c.exp); err == nil {
+			t.Errorf("error expected from FromScaled(%s, %d, %d), none received", c.cur, c.units, c.exp)
+		}
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		New("GBP", "12345.67")
+	}
+}
+
+func BenchmarkAmount(b *testing.B) {
+	m, _ := New("GBP", "12345.67")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Amount()
+	}
+}
+
+func BenchmarkAppendAmount(b *testing.B) {
+	m, _ := New("GBP", "12345.67")
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = m.AppendAmount(buf[:0])
+	}
+}
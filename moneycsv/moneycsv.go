@@ -0,0 +1,140 @@
+// Package moneycsv reads a column of Money from CSV files, one row at a time, so that large
+// files can be validated and ingested in constant memory.
+//
+// Rows that can't be read are reported as *RowError with their line number, and reading
+// continues with the next row, so that one bad price doesn't abort a whole file.
+package moneycsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// Column describes the money column to read. Columns are identified by their names in the
+// file's header row.
+type Column struct {
+	// Amount is the name of the column holding the amount.
+	Amount string
+	// Currency is the name of the column holding each row's currency code.
+	// If it is empty, every amount is in DefaultCurrency.
+	Currency string
+	// DefaultCurrency is the currency of the amounts when there is no Currency column.
+	DefaultCurrency string
+	// Options are the forms of amount accepted.
+	Options dough.ParseOptions
+}
+
+// Row is a row read from a file.
+type Row struct {
+	// Line is the line number of the row's amount, counting from 1.
+	Line  int
+	Money dough.Money
+	// Record is the row's fields. It is reused by the next call to Next, so it must be copied to be kept.
+	Record []string
+}
+
+// RowError describes a row that couldn't be read. Reading can continue after a RowError.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads a money column from CSV.
+type Reader struct {
+	r      *csv.Reader
+	col    Column
+	amount int
+	cur    int
+}
+
+// NewReader returns a Reader of the column c from r, reading the header row to find it.
+// The file's other settings, e.g. its delimiter, can be changed with CSV before the first call to Next.
+// It returns an error if the header can't be read, or doesn't have the columns of c.
+func NewReader(r io.Reader, c Column) (*Reader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read header: %w", err)
+	}
+	res := &Reader{r: cr, col: c, amount: -1, cur: -1}
+	for i, h := range header {
+		switch {
+		case h == c.Amount:
+			res.amount = i
+		case c.Currency != "" && h == c.Currency:
+			res.cur = i
+		}
+	}
+	if res.amount < 0 {
+		return nil, fmt.Errorf("no column %q in header", c.Amount)
+	}
+	if c.Currency != "" && res.cur < 0 {
+		return nil, fmt.Errorf("no column %q in header", c.Currency)
+	}
+	return res, nil
+}
+
+// CSV returns the underlying csv.Reader, to change its settings, e.g. Comma.
+func (r *Reader) CSV() *csv.Reader {
+	return r.r
+}
+
+// Next reads the next row. It returns io.EOF at the end of the file, a *RowError for a row that
+// can't be read, after which Next may be called again, or any other error if reading fails.
+func (r *Reader) Next() (Row, error) {
+	rec, err := r.r.Read()
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		return Row{}, &RowError{perr.StartLine, perr.Err}
+	}
+	if err != nil {
+		return Row{}, err
+	}
+	line, _ := r.r.FieldPos(0)
+	if r.amount >= len(rec) || r.cur >= len(rec) {
+		return Row{}, &RowError{line, fmt.Errorf("expected at least %d fields, got %d", max(r.amount, r.cur)+1, len(rec))}
+	}
+	line, _ = r.r.FieldPos(r.amount)
+	cur := r.col.DefaultCurrency
+	if r.cur >= 0 {
+		cur = rec[r.cur]
+	}
+	x, err := dough.NewWithOptions(cur, rec[r.amount], r.col.Options)
+	if err != nil {
+		return Row{}, &RowError{line, err}
+	}
+	return Row{line, x, rec}, nil
+}
+
+// All returns an iterator over the rows remaining, with the error for each row that can't be read,
+// e.g. to report errors while totalling the rest. It stops at the end of the file, or at the first
+// error that isn't a *RowError.
+func (r *Reader) All() iter.Seq2[Row, error] {
+	return func(yield func(Row, error) bool) {
+		for {
+			row, err := r.Next()
+			if err == io.EOF {
+				return
+			}
+			var rerr *RowError
+			if !yield(row, err) || err != nil && !errors.As(err, &rerr) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package moneycsv
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+const prices = `sku,currency,price
+A1,GBP,1.99
+A2,EUR,2.50
+A3,GBP,abc
+A4,FOO,1.00
+A5
+"A6,GBP,1.00
+A7,GBP,3.00
+`
+
+func TestCanReadColumn(t *testing.T) {
+	r, err := NewReader(strings.NewReader(prices), Column{Amount: "price", Currency: "currency"})
+	if err != nil {
+		t.Fatalf("error received from NewReader, none expected %v", err)
+	}
+	var got []string
+	var lines []int
+	for row, err := range r.All() {
+		var rerr *RowError
+		if errors.As(err, &rerr) {
+			lines = append(lines, rerr.Line)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		got = append(got, row.Record[0]+" "+row.Money.String())
+	}
+	want := []string{"A1 GBP 1.99", "A2 EUR 2.50"}
+	if !slices.Equal(got, want) {
+		t.Errorf("wanted rows %v, got %v", want, got)
+	}
+	// The unterminated quote on line 7 swallows the rest of the file.
+	if wantLines := []int{4, 5, 6, 7}; !slices.Equal(lines, wantLines) {
+		t.Errorf("wanted errors on lines %v, got %v", wantLines, lines)
+	}
+}
+
+func TestCanReadColumnInDefaultCurrency(t *testing.T) {
+	in := "name,amount\nfirst,\"1,234.50\"\nsecond,bad\nthird,\"-0.5\"\n"
+	r, err := NewReader(strings.NewReader(in), Column{
+		Amount:          "amount",
+		DefaultCurrency: "GBP",
+		Options:         dough.ParseOptions{AllowGrouping: true, AllowMissingMinor: true},
+	})
+	if err != nil {
+		t.Fatalf("error received from NewReader, none expected %v", err)
+	}
+	var cases = []struct {
+		want string
+		line int
+	}{
+		{"GBP 1234.50", 2},
+		{"", 3},
+		{"GBP -0.50", 4},
+	}
+	for _, c := range cases {
+		row, err := r.Next()
+		if c.want == "" {
+			var rerr *RowError
+			if !errors.As(err, &rerr) || rerr.Line != c.line {
+				t.Errorf("wanted RowError on line %d, got %v", c.line, err)
+			}
+			continue
+		}
+		if err != nil || row.Money.String() != c.want || row.Line != c.line {
+			t.Errorf("wanted %s on line %d, got %v on line %d (%v)", c.want, c.line, row.Money, row.Line, err)
+		}
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("wanted io.EOF, got %v", err)
+	}
+}
+
+func TestCanIgnoreBlankHeaderCell(t *testing.T) {
+	r, err := NewReader(strings.NewReader("sku,amount,\nA1,1.00,\nA2,2.50,x\n"), Column{Amount: "amount", DefaultCurrency: "GBP"})
+	if err != nil {
+		t.Fatalf("error received from NewReader, none expected %v", err)
+	}
+	for _, want := range []string{"GBP 1.00", "GBP 2.50"} {
+		if row, err := r.Next(); err != nil || row.Money.String() != want {
+			t.Errorf("wanted %s, got %v (%v)", want, row.Money, err)
+		}
+	}
+}
+
+func TestCanSetDelimiter(t *testing.T) {
+	r, err := NewReader(strings.NewReader("amount\n1.00\n"), Column{Amount: "amount", DefaultCurrency: "USD"})
+	if err != nil {
+		t.Fatalf("error received from NewReader, none expected %v", err)
+	}
+	r.CSV().Comma = ';'
+	if row, err := r.Next(); err != nil || row.Money.String() != "USD 1.00" {
+		t.Errorf("wanted USD 1.00, got %v (%v)", row.Money, err)
+	}
+}
+
+func TestCanRejectBadHeader(t *testing.T) {
+	var cases = []struct {
+		in  string
+		col Column
+	}{
+		{"", Column{Amount: "price"}},
+		{"sku,cost\n", Column{Amount: "price"}},
+		{"sku,price\n", Column{Amount: "price", Currency: "currency"}},
+	}
+	for _, c := range cases {
+		if _, err := NewReader(strings.NewReader(c.in), c.col); err == nil {
+			t.Errorf("error expected from NewReader(%q, %+v), none received", c.in, c.col)
+		}
+	}
+}
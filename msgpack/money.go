@@ -0,0 +1,63 @@
+// Package msgpack provides MessagePack encoding of Money for github.com/vmihailenco/msgpack.
+//
+// Money is encoded as a 3-element array of [currency code, exponent, minor units],
+// e.g. ["GBP", 2, 12345] for £123.45.
+package msgpack
+
+import (
+	"fmt"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Money is a dough.Money that implements msgpack.CustomEncoder and msgpack.CustomDecoder.
+type Money struct {
+	dough.Money
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+func (m Money) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if err := enc.EncodeArrayLen(3); err != nil {
+		return err
+	}
+	if err := enc.EncodeString(m.Currency()); err != nil {
+		return err
+	}
+	if err := enc.EncodeInt(int64(m.Exponent())); err != nil {
+		return err
+	}
+	return enc.EncodeInt(m.MinorUnits())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+func (m *Money) DecodeMsgpack(dec *msgpack.Decoder) error {
+	n, err := dec.DecodeArrayLen()
+	if err != nil {
+		return err
+	}
+	if n != 3 {
+		return fmt.Errorf("couldn't decode Money: expected array of 3 elements, got %d", n)
+	}
+	cur, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	exp, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	units, err := dec.DecodeInt64()
+	if err != nil {
+		return err
+	}
+	if exp < 0 {
+		return fmt.Errorf("couldn't decode Money: invalid exponent %d", exp)
+	}
+	x, err := dough.FromScaled(cur, units, exp)
+	if err != nil {
+		return err
+	}
+	m.Money = x
+	return nil
+}
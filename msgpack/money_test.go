@@ -0,0 +1,70 @@
+package msgpack
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestCanRoundTripMsgpack(t *testing.T) {
+	var cases = []struct {
+		cur string
+		amt string
+	}{
+		{"GBP", "123.45"},
+		{"EUR", "-0.01"},
+		{"AUD", "0.00"},
+		{"USD", "92233720368547758.07"},
+	}
+	for _, c := range cases {
+		x, _ := dough.New(c.cur, c.amt)
+		b, err := msgpack.Marshal(Money{x})
+		if err != nil {
+			t.Errorf("error received from Marshal(%v), none expected %v", x, err)
+		}
+		var got Money
+		if err := msgpack.Unmarshal(b, &got); err != nil {
+			t.Errorf("error received from Unmarshal(%v), none expected %v", x, err)
+		}
+		if got.Money != x {
+			t.Errorf("wanted %v, got %v", x, got.Money)
+		}
+	}
+}
+
+func TestCanEncodeMsgpackCompactly(t *testing.T) {
+	x, _ := dough.New("GBP", "1.00")
+	b, _ := msgpack.Marshal(Money{x})
+	// fixarray(3), fixstr(3) "GBP", fixint 2, fixint 100.
+	want := []byte{0x93, 0xa3, 'G', 'B', 'P', 0x02, 0x64}
+	if !bytes.Equal(b, want) {
+		t.Errorf("wanted % x, got % x", want, b)
+	}
+}
+
+func TestCanRejectBadMsgpack(t *testing.T) {
+	var cases = []struct {
+		v interface{}
+	}{
+		{"GBP 1.00"},
+		{[]interface{}{"GBP", 2}},
+		{[]interface{}{"FOO", 2, 100}},
+		{[]interface{}{"GBP", -2, 100}},
+		{[]interface{}{"GBP", 3, 1001}},
+		{[]interface{}{2, "GBP", 100}},
+		// Huge exponents must be rejected, not scaled down one digit at a time.
+		{[]interface{}{"GBP", 1 << 40, 1}},
+		{[]interface{}{"GBP", 1 << 40, 0}},
+		{[]interface{}{"GBP", int64(math.MaxInt64), 100}},
+	}
+	for _, c := range cases {
+		b, _ := msgpack.Marshal(c.v)
+		var m Money
+		if err := msgpack.Unmarshal(b, &m); err == nil {
+			t.Errorf("error expected from Unmarshal(%v), none received", c.v)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MulDecimal returns x multiplied by the decimal number f, e.g. "1.175", rounded to the currency's
+// minor unit using mode. The product is calculated exactly, so it is rounded only once.
+// Pass Default to use the package's default rounding mode; see DefaultRounding.
+// It returns an error if f is not a decimal number, or one wrapping ErrOutOfRange if the result can't be represented.
+func (x Money) MulDecimal(f string, mode RoundingMode) (Money, error) {
+	r, err := parseDecimal(f)
+	if err != nil {
+		return Money{}, err
+	}
+	a, err := roundAtoms(r.Mul(r, big.NewRat(int64(x.a), 1)), mode)
+	if err != nil {
+		return Money{}, fmt.Errorf("%v × %s: %w", x, f, err)
+	}
+	return Money{x.c, a}, nil
+}
+
+// Percent returns p% of x, e.g. 17.5% of £10.00, rounded to the currency's minor unit using mode.
+// p is taken as the shortest decimal that represents it, e.g. 17.5 for 17.5, so percentages
+// written in decimal aren't affected by their binary representation.
+// Pass Default to use the package's default rounding mode; see DefaultRounding.
+// It returns an error if p is negative or not finite, or if the result can't be represented.
+func (x Money) Percent(p float64, mode RoundingMode) (Money, error) {
+	return percentOf(x, p, mode)
+}
@@ -0,0 +1,79 @@
+package dough
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestCanMulDecimal(t *testing.T) {
+	var cases = []struct {
+		x    string
+		f    string
+		mode RoundingMode
+		want string
+	}{
+		{"10.00", "1.175", HalfUp, "11.75"},
+		{"0.10", "0.5", HalfUp, "0.05"},
+		{"0.01", "0.5", HalfUp, "0.01"},
+		{"0.01", "0.5", HalfEven, "0.00"},
+		{"0.03", "0.5", HalfEven, "0.02"},
+		{"-0.01", "0.5", HalfUp, "-0.01"},
+		{"1.00", "-2", Down, "-2.00"},
+		{"3.33", "0.333", Floor, "1.10"},
+	}
+	for _, c := range cases {
+		if got, err := gbp(c.x).MulDecimal(c.f, c.mode); err != nil || got.Amount() != c.want {
+			t.Errorf("%s.MulDecimal(%s, %v): wanted %s, got %v (%v)", c.x, c.f, c.mode, c.want, got, err)
+		}
+	}
+	if _, err := gbp("1.00").MulDecimal("1e3", HalfUp); err == nil {
+		t.Errorf("error expected from MulDecimal with bad factor, none received")
+	}
+	hi, _ := MaxValue("GBP")
+	if _, err := hi.MulDecimal("1.5", HalfUp); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("wanted ErrOutOfRange from MulDecimal, got %v", err)
+	}
+}
+
+func TestCanPercent(t *testing.T) {
+	if got, err := gbp("10.00").Percent(17.5, HalfUp); err != nil || got.Amount() != "1.75" {
+		t.Errorf("17.5%% of 10.00: wanted 1.75, got %v (%v)", got, err)
+	}
+	if _, err := gbp("10.00").Percent(-1, HalfUp); err == nil {
+		t.Errorf("error expected from Percent with negative percentage, none received")
+	}
+}
+
+// With a half-up default, totals of rounded halves drift upwards; with a half-even default they don't.
+func TestCanUseDefaultRoundingForMultiplication(t *testing.T) {
+	defer SetDefaultRounding(HalfUp)
+	var cases = []struct {
+		mode RoundingMode
+		want string
+	}{
+		{HalfUp, "25.50"},
+		{HalfEven, "25.25"},
+	}
+	for _, c := range cases {
+		SetDefaultRounding(c.mode)
+		mul, pct, conv := gbp("0.00"), gbp("0.00"), MustNew("EUR", "0.00")
+		rates := RateFunc(func(from, to string) (*big.Rat, error) {
+			return big.NewRat(1, 2), nil
+		})
+		for i := 1; i <= 100; i++ {
+			x, _ := FromMinorUnits("GBP", int64(i))
+			m, _ := x.MulDecimal("0.5", Default)
+			p, _ := x.Percent(50, Default)
+			e, _ := Convert(x, "EUR", rates, Default)
+			mul, _ = mul.Add(m)
+			pct, _ = pct.Add(p)
+			conv, _ = conv.Add(e)
+		}
+		for _, got := range []Money{mul, pct, conv} {
+			if got.Amount() != c.want {
+				t.Errorf("half of 0.01 to 1.00 with %v default: wanted total %s, got %v", c.mode, c.want, got)
+			}
+		}
+	}
+}
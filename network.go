@@ -0,0 +1,100 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"golang.org/x/text/currency"
+)
+
+// Network identifies a card network whose treatment of minor units may differ from ISO 4217.
+type Network string
+
+// Card networks with built-in minor unit rules.
+const (
+	Visa       Network = "visa"
+	Mastercard Network = "mastercard"
+)
+
+var networkExponents = struct {
+	sync.RWMutex
+	m map[Network]map[currency.Unit]int
+}{
+	m: map[Network]map[currency.Unit]int{
+		// ISO 4217 gives ISK no minor unit, but the networks still expect two decimal places,
+		// so ISK 1 is sent as 100.
+		// HUF keeps its two ISO 4217 decimal places on the networks, although fillér are no longer
+		// in circulation and some processors treat HUF as having none; the rule makes that explicit,
+		// so HUF 1 is sent as 100, not 1.
+		Visa: {
+			currency.MustParseISO("ISK"): 2,
+			currency.MustParseISO("HUF"): 2,
+		},
+		Mastercard: {
+			currency.MustParseISO("ISK"): 2,
+			currency.MustParseISO("HUF"): 2,
+		},
+	},
+}
+
+// RegisterNetworkExponent sets the number of minor unit digits that network n uses for currency cur,
+// overriding any built-in rule.
+// It returns an error if cur is not well formed or not recognised, or if exp isn't between 0 and 18.
+func RegisterNetworkExponent(n Network, cur string, exp int) error {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if exp < 0 || exp > maxCurrencyExponent {
+		return fmt.Errorf("exponent must be between 0 and %d, got %d", maxCurrencyExponent, exp)
+	}
+	networkExponents.Lock()
+	defer networkExponents.Unlock()
+	if networkExponents.m[n] == nil {
+		networkExponents.m[n] = map[currency.Unit]int{}
+	}
+	networkExponents.m[n][c] = exp
+	return nil
+}
+
+// NetworkExponent returns the number of minor unit digits network n uses for the currency of x.
+// Unless a rule has been registered, this is the currency's ISO 4217 minor unit.
+func NetworkExponent(n Network, x Money) int {
+	networkExponents.RLock()
+	defer networkExponents.RUnlock()
+	if e, ok := networkExponents.m[n][x.c]; ok {
+		return e
+	}
+	return x.Exponent()
+}
+
+// ToNetworkMinorUnits returns the amount of x in the minor units network n expects,
+// e.g. 100 for ISK 1 on Visa.
+// It returns an error if x can't be represented exactly, or would overflow.
+func ToNetworkMinorUnits(n Network, x Money) (int64, error) {
+	u := x.MinorUnits()
+	for exp := x.Exponent(); exp < NetworkExponent(n, x); exp++ {
+		if u > math.MaxInt64/10 || u < math.MinInt64/10 {
+			return 0, fmt.Errorf("%v is out of range for %s", x, n)
+		}
+		u *= 10
+	}
+	for exp := x.Exponent(); exp > NetworkExponent(n, x); exp-- {
+		if u%10 != 0 {
+			return 0, fmt.Errorf("%v can't be represented exactly in %s minor units", x, n)
+		}
+		u /= 10
+	}
+	return u, nil
+}
+
+// FromNetworkMinorUnits returns the Money for an amount in the minor units network n uses for cur.
+// It returns an error if cur is not recognised, or the amount can't be represented exactly.
+func FromNetworkMinorUnits(n Network, cur string, units int64) (Money, error) {
+	z, err := FromMinorUnits(cur, 0)
+	if err != nil {
+		return Money{}, err
+	}
+	return FromScaled(cur, units, NetworkExponent(n, z))
+}
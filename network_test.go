@@ -0,0 +1,70 @@
+package dough
+
+import "testing"
+
+func TestCanConvertToNetworkMinorUnits(t *testing.T) {
+	var cases = []struct {
+		n    Network
+		cur  string
+		amt  string
+		want int64
+	}{
+		{Visa, "GBP", "1.23", 123},
+		{Visa, "JPY", "123", 123},
+		{Visa, "ISK", "123", 12300},
+		{Mastercard, "ISK", "-5", -500},
+		{Network("other"), "ISK", "123", 123},
+		{Visa, "HUF", "1.00", 100},
+		{Mastercard, "HUF", "-1234.50", -123450},
+	}
+	for _, c := range cases {
+		x, _ := New(c.cur, c.amt)
+		got, err := ToNetworkMinorUnits(c.n, x)
+		if err != nil || got != c.want {
+			t.Errorf("ToNetworkMinorUnits(%s, %v): wanted %d, got %d (%v)", c.n, x, c.want, got, err)
+		}
+		back, err := FromNetworkMinorUnits(c.n, c.cur, got)
+		if err != nil || back != x {
+			t.Errorf("FromNetworkMinorUnits(%s, %s, %d): wanted %v, got %v (%v)", c.n, c.cur, got, x, back, err)
+		}
+	}
+}
+
+func TestCanRegisterNetworkExponent(t *testing.T) {
+	n := Network("test")
+	if err := RegisterNetworkExponent(n, "huf", 0); err != nil {
+		t.Fatalf("error received from RegisterNetworkExponent, none expected %v", err)
+	}
+	x, _ := New("HUF", "100.00")
+	if got, err := ToNetworkMinorUnits(n, x); err != nil || got != 100 {
+		t.Errorf("wanted 100, got %d (%v)", got, err)
+	}
+	y, _ := New("HUF", "100.50")
+	if _, err := ToNetworkMinorUnits(n, y); err == nil {
+		t.Errorf("error expected converting HUF 100.50 to zero-decimal units, none received")
+	}
+	if _, err := FromNetworkMinorUnits(n, "HUF", 100); err != nil {
+		t.Errorf("error received from FromNetworkMinorUnits, none expected %v", err)
+	}
+	if _, err := FromNetworkMinorUnits(n, "FOO", 100); err == nil {
+		t.Errorf("error expected from FromNetworkMinorUnits with bad currency, none received")
+	}
+}
+
+func TestCanRejectBadNetworkExponent(t *testing.T) {
+	var cases = []struct {
+		cur string
+		exp int
+	}{
+		{"FOO", 2},
+		{"", 2},
+		{"HUF", -1},
+		{"HUF", 19},
+		{"HUF", 1 << 40},
+	}
+	for _, c := range cases {
+		if err := RegisterNetworkExponent(Network("test"), c.cur, c.exp); err == nil {
+			t.Errorf("error expected from RegisterNetworkExponent(%s, %d), none received", c.cur, c.exp)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package dough
+
+import "golang.org/x/text/currency"
+
+// numericCodes holds the ISO 4217 numeric code of each currency that has one and is recognised by currency.ParseISO.
+// https://www.iso.org/iso-4217-currency-codes.html
+var numericCodes = map[currency.Unit]uint16{
+	currency.MustParseISO("AED"): 784,
+	currency.MustParseISO("AFN"): 971,
+	currency.MustParseISO("ALL"): 8,
+	currency.MustParseISO("AMD"): 51,
+	currency.MustParseISO("ANG"): 532,
+	currency.MustParseISO("AOA"): 973,
+	currency.MustParseISO("ARS"): 32,
+	currency.MustParseISO("AUD"): 36,
+	currency.MustParseISO("AWG"): 533,
+	currency.MustParseISO("AZN"): 944,
+	currency.MustParseISO("BAM"): 977,
+	currency.MustParseISO("BBD"): 52,
+	currency.MustParseISO("BDT"): 50,
+	currency.MustParseISO("BGN"): 975,
+	currency.MustParseISO("BHD"): 48,
+	currency.MustParseISO("BIF"): 108,
+	currency.MustParseISO("BMD"): 60,
+	currency.MustParseISO("BND"): 96,
+	currency.MustParseISO("BOB"): 68,
+	currency.MustParseISO("BOV"): 984,
+	currency.MustParseISO("BRL"): 986,
+	currency.MustParseISO("BSD"): 44,
+	currency.MustParseISO("BTN"): 64,
+	currency.MustParseISO("BWP"): 72,
+	currency.MustParseISO("BYN"): 933,
+	currency.MustParseISO("BZD"): 84,
+	currency.MustParseISO("CAD"): 124,
+	currency.MustParseISO("CDF"): 976,
+	currency.MustParseISO("CHE"): 947,
+	currency.MustParseISO("CHF"): 756,
+	currency.MustParseISO("CHW"): 948,
+	currency.MustParseISO("CLF"): 990,
+	currency.MustParseISO("CLP"): 152,
+	currency.MustParseISO("CNY"): 156,
+	currency.MustParseISO("COP"): 170,
+	currency.MustParseISO("COU"): 970,
+	currency.MustParseISO("CRC"): 188,
+	currency.MustParseISO("CUC"): 931,
+	currency.MustParseISO("CUP"): 192,
+	currency.MustParseISO("CVE"): 132,
+	currency.MustParseISO("CZK"): 203,
+	currency.MustParseISO("DJF"): 262,
+	currency.MustParseISO("DKK"): 208,
+	currency.MustParseISO("DOP"): 214,
+	currency.MustParseISO("DZD"): 12,
+	currency.MustParseISO("EGP"): 818,
+	currency.MustParseISO("ERN"): 232,
+	currency.MustParseISO("ETB"): 230,
+	currency.MustParseISO("EUR"): 978,
+	currency.MustParseISO("FJD"): 242,
+	currency.MustParseISO("FKP"): 238,
+	currency.MustParseISO("GBP"): 826,
+	currency.MustParseISO("GEL"): 981,
+	currency.MustParseISO("GHS"): 936,
+	currency.MustParseISO("GIP"): 292,
+	currency.MustParseISO("GMD"): 270,
+	currency.MustParseISO("GNF"): 324,
+	currency.MustParseISO("GTQ"): 320,
+	currency.MustParseISO("GYD"): 328,
+	currency.MustParseISO("HKD"): 344,
+	currency.MustParseISO("HNL"): 340,
+	currency.MustParseISO("HTG"): 332,
+	currency.MustParseISO("HUF"): 348,
+	currency.MustParseISO("IDR"): 360,
+	currency.MustParseISO("ILS"): 376,
+	currency.MustParseISO("INR"): 356,
+	currency.MustParseISO("IQD"): 368,
+	currency.MustParseISO("IRR"): 364,
+	currency.MustParseISO("ISK"): 352,
+	currency.MustParseISO("JMD"): 388,
+	currency.MustParseISO("JOD"): 400,
+	currency.MustParseISO("JPY"): 392,
+	currency.MustParseISO("KES"): 404,
+	currency.MustParseISO("KGS"): 417,
+	currency.MustParseISO("KHR"): 116,
+	currency.MustParseISO("KMF"): 174,
+	currency.MustParseISO("KPW"): 408,
+	currency.MustParseISO("KRW"): 410,
+	currency.MustParseISO("KWD"): 414,
+	currency.MustParseISO("KYD"): 136,
+	currency.MustParseISO("KZT"): 398,
+	currency.MustParseISO("LAK"): 418,
+	currency.MustParseISO("LBP"): 422,
+	currency.MustParseISO("LKR"): 144,
+	currency.MustParseISO("LRD"): 430,
+	currency.MustParseISO("LSL"): 426,
+	currency.MustParseISO("LYD"): 434,
+	currency.MustParseISO("MAD"): 504,
+	currency.MustParseISO("MDL"): 498,
+	currency.MustParseISO("MGA"): 969,
+	currency.MustParseISO("MKD"): 807,
+	currency.MustParseISO("MMK"): 104,
+	currency.MustParseISO("MNT"): 496,
+	currency.MustParseISO("MOP"): 446,
+	currency.MustParseISO("MUR"): 480,
+	currency.MustParseISO("MVR"): 462,
+	currency.MustParseISO("MWK"): 454,
+	currency.MustParseISO("MXN"): 484,
+	currency.MustParseISO("MXV"): 979,
+	currency.MustParseISO("MYR"): 458,
+	currency.MustParseISO("MZN"): 943,
+	currency.MustParseISO("NAD"): 516,
+	currency.MustParseISO("NGN"): 566,
+	currency.MustParseISO("NIO"): 558,
+	currency.MustParseISO("NOK"): 578,
+	currency.MustParseISO("NPR"): 524,
+	currency.MustParseISO("NZD"): 554,
+	currency.MustParseISO("OMR"): 512,
+	currency.MustParseISO("PAB"): 590,
+	currency.MustParseISO("PEN"): 604,
+	currency.MustParseISO("PGK"): 598,
+	currency.MustParseISO("PHP"): 608,
+	currency.MustParseISO("PKR"): 586,
+	currency.MustParseISO("PLN"): 985,
+	currency.MustParseISO("PYG"): 600,
+	currency.MustParseISO("QAR"): 634,
+	currency.MustParseISO("RON"): 946,
+	currency.MustParseISO("RSD"): 941,
+	currency.MustParseISO("RUB"): 643,
+	currency.MustParseISO("RWF"): 646,
+	currency.MustParseISO("SAR"): 682,
+	currency.MustParseISO("SBD"): 90,
+	currency.MustParseISO("SCR"): 690,
+	currency.MustParseISO("SDG"): 938,
+	currency.MustParseISO("SEK"): 752,
+	currency.MustParseISO("SGD"): 702,
+	currency.MustParseISO("SHP"): 654,
+	currency.MustParseISO("SLL"): 694,
+	currency.MustParseISO("SOS"): 706,
+	currency.MustParseISO("SRD"): 968,
+	currency.MustParseISO("SSP"): 728,
+	currency.MustParseISO("STN"): 930,
+	currency.MustParseISO("SVC"): 222,
+	currency.MustParseISO("SYP"): 760,
+	currency.MustParseISO("SZL"): 748,
+	currency.MustParseISO("THB"): 764,
+	currency.MustParseISO("TJS"): 972,
+	currency.MustParseISO("TMT"): 934,
+	currency.MustParseISO("TND"): 788,
+	currency.MustParseISO("TOP"): 776,
+	currency.MustParseISO("TRY"): 949,
+	currency.MustParseISO("TTD"): 780,
+	currency.MustParseISO("TWD"): 901,
+	currency.MustParseISO("TZS"): 834,
+	currency.MustParseISO("UAH"): 980,
+	currency.MustParseISO("UGX"): 800,
+	currency.MustParseISO("USD"): 840,
+	currency.MustParseISO("USN"): 997,
+	currency.MustParseISO("UYI"): 940,
+	currency.MustParseISO("UYU"): 858,
+	currency.MustParseISO("UZS"): 860,
+	currency.MustParseISO("VND"): 704,
+	currency.MustParseISO("VUV"): 548,
+	currency.MustParseISO("WST"): 882,
+	currency.MustParseISO("XAF"): 950,
+	currency.MustParseISO("XAG"): 961,
+	currency.MustParseISO("XAU"): 959,
+	currency.MustParseISO("XBA"): 955,
+	currency.MustParseISO("XBB"): 956,
+	currency.MustParseISO("XBC"): 957,
+	currency.MustParseISO("XBD"): 958,
+	currency.MustParseISO("XCD"): 951,
+	currency.MustParseISO("XDR"): 960,
+	currency.MustParseISO("XOF"): 952,
+	currency.MustParseISO("XPD"): 964,
+	currency.MustParseISO("XPF"): 953,
+	currency.MustParseISO("XPT"): 962,
+	currency.MustParseISO("XSU"): 994,
+	currency.MustParseISO("XTS"): 963,
+	currency.MustParseISO("XUA"): 965,
+	currency.MustParseISO("XXX"): 999,
+	currency.MustParseISO("YER"): 886,
+	currency.MustParseISO("ZAR"): 710,
+	currency.MustParseISO("ZMW"): 967,
+	currency.MustParseISO("ZWL"): 932,
+}
+
+// currenciesByNumericCode is the inverse of numericCodes.
+var currenciesByNumericCode = func() map[uint16]currency.Unit {
+	m := make(map[uint16]currency.Unit, len(numericCodes))
+	for c, n := range numericCodes {
+		m[n] = c
+	}
+	return m
+}()
@@ -0,0 +1,30 @@
+package dough
+
+import "testing"
+
+func TestCanLookUpNumericCodes(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		want uint16
+	}{
+		{"GBP", 826},
+		{"USD", 840},
+		{"EUR", 978},
+		{"JPY", 392},
+		{"ALL", 8},
+		{"XXX", 999},
+	}
+	for _, c := range cases {
+		if got := numericCodes[MustNew(c.cur, "0").c]; got != c.want {
+			t.Errorf("numeric code of %s: wanted %03d, got %03d", c.cur, c.want, got)
+		}
+	}
+	if len(currenciesByNumericCode) != len(numericCodes) {
+		t.Errorf("wanted numeric codes to be unique, got %d codes for %d currencies", len(currenciesByNumericCode), len(numericCodes))
+	}
+	for c, n := range numericCodes {
+		if currenciesByNumericCode[n] != c {
+			t.Errorf("wanted %03d to map back to %s, got %s", n, c, currenciesByNumericCode[n])
+		}
+	}
+}
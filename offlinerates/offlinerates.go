@@ -0,0 +1,74 @@
+// Package offlinerates embeds a snapshot of euro reference rates, so that development
+// environments and tests can convert currencies without network access.
+//
+// The rates are indicative values in the format of the European Central Bank's euro foreign
+// exchange reference rates, as of AsOf. They are fixed at build time and will be out of date:
+// they must not be used for real conversions. Use them as a last resort, e.g.
+//
+//	dough.Fallback(live, offlinerates.Provider())
+package offlinerates
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+//go:embed eurofxref.csv
+var eurofxref string
+
+// AsOf is the date of the embedded rates.
+var AsOf time.Time
+
+var rates []dough.Rate
+
+func init() {
+	var err error
+	if AsOf, rates, err = parse(eurofxref); err != nil {
+		panic(fmt.Sprintf("offlinerates: bad embedded rates: %v", err))
+	}
+}
+
+// parse parses rates in the ECB's CSV format: a header row of "Date" followed by currency codes,
+// and a row of the date followed by the amount of each currency that one euro buys.
+func parse(s string) (time.Time, []dough.Rate, error) {
+	rows, err := csv.NewReader(strings.NewReader(s)).ReadAll()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if len(rows) != 2 || len(rows[0]) < 2 || rows[0][0] != "Date" {
+		return time.Time{}, nil, fmt.Errorf("expected header and one row of rates")
+	}
+	date, err := time.Parse("2006-01-02", rows[1][0])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	rs := make([]dough.Rate, 0, len(rows[0])-1)
+	for i, cur := range rows[0][1:] {
+		r, err := dough.ParseRate("EUR", strings.TrimSpace(cur), strings.TrimSpace(rows[1][i+1]))
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		rs = append(rs, r)
+	}
+	return date, rs, nil
+}
+
+// Rates returns the embedded rates, each from the euro to another currency.
+func Rates() []dough.Rate {
+	return append([]dough.Rate(nil), rates...)
+}
+
+// Provider returns a dough.RateProvider of the embedded rates. Rates between two currencies
+// other than the euro are exact cross rates via the euro.
+func Provider() dough.RateProvider {
+	return dough.Triangulator{
+		Rates:   dough.NewRateTable(rates...),
+		Bridges: []string{"EUR"},
+		MaxHops: 2,
+	}
+}
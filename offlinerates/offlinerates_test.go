@@ -0,0 +1,62 @@
+package offlinerates
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanUseEmbeddedRates(t *testing.T) {
+	if AsOf.Format("2006-01-02") != "2024-01-02" {
+		t.Errorf("wanted rates as of 2024-01-02, got %v", AsOf)
+	}
+	if n := len(Rates()); n != 30 {
+		t.Errorf("wanted 30 rates, got %d", n)
+	}
+	p := Provider()
+	var cases = []struct {
+		from, to string
+		want     string
+	}{
+		{"EUR", "GBP", "8679/10000"},
+		{"GBP", "EUR", "10000/8679"},
+		{"GBP", "USD", "332/263"},
+	}
+	for _, c := range cases {
+		if got, err := p.Rate(c.from, c.to); err != nil || got.RatString() != c.want {
+			t.Errorf("Rate(%s, %s): wanted %s, got %v (%v)", c.from, c.to, c.want, got, err)
+		}
+	}
+	if _, err := p.Rate("GBP", "KWD"); err == nil {
+		t.Errorf("error expected from Rate for currency not in snapshot, none received")
+	}
+}
+
+func TestCanFallBackToEmbeddedRates(t *testing.T) {
+	down := dough.RateFunc(func(from, to string) (*big.Rat, error) {
+		return nil, fmt.Errorf("rate service unavailable")
+	})
+	got, err := dough.Convert(dough.MustNew("EUR", "100.00"), "USD", dough.Fallback(down, Provider()), dough.HalfUp)
+	if err != nil || got.String() != "USD 109.56" {
+		t.Errorf("wanted USD 109.56, got %v (%v)", got, err)
+	}
+}
+
+func TestCanRejectBadSnapshot(t *testing.T) {
+	var cases = []string{
+		"",
+		"Date,USD\n",
+		"Day,USD\n2024-01-02,1.1\n",
+		"Date,USD\n2 Jan 2024,1.1\n",
+		"Date,USD\n2024-01-02,abc\n",
+		"Date,FOO\n2024-01-02,1.1\n",
+		"Date,USD\n2024-01-02,1.1\n2024-01-03,1.2\n",
+	}
+	for _, s := range cases {
+		if _, _, err := parse(s); err == nil {
+			t.Errorf("error expected parsing %q, none received", s)
+		}
+	}
+}
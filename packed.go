@@ -0,0 +1,62 @@
+package dough
+
+import (
+	"fmt"
+
+	"golang.org/x/text/currency"
+)
+
+// Packed is a Money packed into 64 bits: the currency's ISO 4217 numeric code in the top 10 bits,
+// and the amount in minor units, in two's complement, in the other 54.
+//
+// A Packed is 8 bytes, where a Money is 16 on 64-bit platforms, so it suits holding very large
+// numbers of amounts, e.g. in an in-memory price cache, converting to Money to work with them.
+// The saving is smaller once a container's own overhead is counted: in BenchmarkPackedCache,
+// a map of ten million SKUs to amounts takes 303 MB as Packed and 447 MB as Money, where slices
+// of the same take 80 MB and 160 MB.
+// The cost is range: amounts are limited to ±(2^53 − 1) minor units, e.g. about £90 trillion,
+// and currencies to those with a numeric code.
+//
+// Like Money, Packed is comparable. The zero value unpacks to the zero Money.
+type Packed uint64
+
+const (
+	packedAmountBits = 54
+	maxPacked        = 1<<(packedAmountBits-1) - 1
+)
+
+// currenciesByPackedCode maps numeric codes to currencies, for fast unpacking.
+// Codes with no currency map to the zero currency.Unit.
+var currenciesByPackedCode = func() (t [1 << (64 - packedAmountBits)]currency.Unit) {
+	for n, c := range currenciesByNumericCode {
+		t[n] = c
+	}
+	return t
+}()
+
+// Pack returns x packed into 64 bits.
+// It returns an error if x's currency has no numeric code, or one wrapping ErrOutOfRange if x's
+// amount is too large in magnitude.
+func Pack(x Money) (Packed, error) {
+	n, ok := numericCodes[x.c]
+	if !ok {
+		return 0, fmt.Errorf("couldn't pack Money: %s has no numeric code", x.Currency())
+	}
+	if x.a > maxPacked || x.a < -maxPacked {
+		return 0, fmt.Errorf("couldn't pack Money: %w: %v", ErrOutOfRange, x)
+	}
+	return Packed(uint64(n)<<packedAmountBits | uint64(x.a)&(1<<packedAmountBits-1)), nil
+}
+
+// Unpack returns the Money packed into p.
+func (p Packed) Unpack() Money {
+	return Money{
+		currenciesByPackedCode[p>>packedAmountBits],
+		int(int64(p<<(64-packedAmountBits)) >> (64 - packedAmountBits)),
+	}
+}
+
+// String returns the unpacked Money as a string, e.g. "GBP 123.45".
+func (p Packed) String() string {
+	return p.Unpack().String()
+}
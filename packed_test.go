@@ -0,0 +1,120 @@
+package dough
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+)
+
+func TestCanPack(t *testing.T) {
+	var cases = []Money{
+		MustNew("GBP", "123.45"),
+		MustNew("GBP", "-123.45"),
+		MustNew("JPY", "0"),
+		MustNew("KWD", "-0.001"),
+		MustNew("USD", "90071992547409.91"),
+		MustNew("USD", "-90071992547409.91"),
+		{},
+	}
+	for _, x := range cases {
+		p, err := Pack(x)
+		if err != nil {
+			t.Errorf("error received from Pack(%v), none expected %v", x, err)
+			continue
+		}
+		if got := p.Unpack(); got != x || p.String() != x.String() {
+			t.Errorf("Pack(%v).Unpack(): got %v", x, got)
+		}
+	}
+	if got := Packed(0).Unpack(); got != (Money{}) {
+		t.Errorf("wanted zero Packed to unpack to zero Money, got %v", got)
+	}
+	// Packed values compare as Money does.
+	a, _ := Pack(MustNew("GBP", "1.00"))
+	b, _ := Pack(MustNew("GBP", "1.00"))
+	c, _ := Pack(MustNew("EUR", "1.00"))
+	if a != b || a == c {
+		t.Errorf("wanted equal Packed for equal Money and different for different currencies")
+	}
+}
+
+func TestCanRejectBadPack(t *testing.T) {
+	for _, amt := range []string{"90071992547409.92", "-90071992547409.92", "92233720368547758.07"} {
+		if _, err := Pack(MustNew("USD", amt)); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("wanted ErrOutOfRange from Pack(USD %s), got %v", amt, err)
+		}
+	}
+	if _, err := Pack(MustNew("DEM", "1.00")); err == nil {
+		t.Errorf("error expected from Pack with currency with no numeric code, none received")
+	}
+}
+
+// The cache benchmarks build, then scan, an in-memory price cache of ten million SKUs, and report
+// the cache's size on the heap as MB/cache alongside the time taken. Run them with e.g.
+// -bench=Cache -benchtime=3x, as each iteration builds a whole cache.
+
+const benchCacheSize = 10000000
+
+// heapAlloc returns the bytes allocated on the heap after a collection.
+func heapAlloc() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func BenchmarkMoneyCache(b *testing.B) {
+	x := MustNew("GBP", "12345.67")
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		before := heapAlloc()
+		b.StartTimer()
+		cache := make(map[int32]Money, benchCacheSize)
+		for sku := int32(0); sku < benchCacheSize; sku++ {
+			cache[sku] = x
+		}
+		var total int64
+		for _, m := range cache {
+			total += m.MinorUnits()
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(heapAlloc()-before)/1e6, "MB/cache")
+		runtime.KeepAlive(cache)
+		b.StartTimer()
+	}
+}
+
+func BenchmarkPackedCache(b *testing.B) {
+	x, _ := Pack(MustNew("GBP", "12345.67"))
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		before := heapAlloc()
+		b.StartTimer()
+		cache := make(map[int32]Packed, benchCacheSize)
+		for sku := int32(0); sku < benchCacheSize; sku++ {
+			cache[sku] = x
+		}
+		var total int64
+		for _, p := range cache {
+			total += p.Unpack().MinorUnits()
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(heapAlloc()-before)/1e6, "MB/cache")
+		runtime.KeepAlive(cache)
+		b.StartTimer()
+	}
+}
+
+func BenchmarkPack(b *testing.B) {
+	x := MustNew("GBP", "12345.67")
+	for i := 0; i < b.N; i++ {
+		Pack(x)
+	}
+}
+
+func BenchmarkUnpack(b *testing.B) {
+	p, _ := Pack(MustNew("GBP", "12345.67"))
+	for i := 0; i < b.N; i++ {
+		_ = p.Unpack()
+	}
+}
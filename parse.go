@@ -0,0 +1,247 @@
+package dough
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// ParseOptions relaxes the amount syntax accepted by NewWithOptions and ParseWithOptions.
+// The zero value accepts exactly what New accepts, which is what payment-critical code should use.
+type ParseOptions struct {
+	// AllowGrouping allows commas between groups of three digits, e.g. "1,234.56".
+	AllowGrouping bool
+	// AllowSymbols allows the currency's symbol before the amount, e.g. "£12.34" or "-£12.34".
+	AllowSymbols bool
+	// AllowMissingMinor allows fewer decimal places than the currency's minor unit, e.g. "12.5" or "12.".
+	AllowMissingMinor bool
+	// AllowLeadingPlus allows a leading plus sign, e.g. "+12.34".
+	AllowLeadingPlus bool
+	// AllowAliases allows currency codes registered as aliases, e.g. "RMB" for CNY, or "TRL" for TRY.
+	// Amounts in a superseded currency are converted to its replacement, and must be exactly representable in it.
+	AllowAliases bool
+	// AllowParentheses allows a negative amount to be written in parentheses, accounting style, e.g. "(123.45)"
+	// or "(£123.45)", as found in bank and ERP exports. An amount in parentheses can't also have a sign.
+	AllowParentheses bool
+	// AllowTrailingSign allows a minus sign after the amount, e.g. "123.45-", as found in legacy banking exports.
+	AllowTrailingSign bool
+	// CreditDebit allows an amount to be marked as a credit or debit, e.g. "123.45 CR" or "123.45DR",
+	// and determines which of them is negative. An amount marked CR or DR can't also have a sign.
+	CreditDebit CreditDebit
+	// AllowExponent allows scientific notation, e.g. "1.2e3" or "-5E-2", as emitted by some upstream systems.
+	// The amount is expanded exactly, and must still be representable in the currency's minor units,
+	// so "1.2345e1" isn't allowed for GBP. Without it, such amounts are rejected with ErrScientificNotation.
+	AllowExponent bool
+	// Normalize allows the amount to have surrounding whitespace, a leading plus sign and leading zeros,
+	// e.g. " +0001.23 ", as found in bank statement exports.
+	Normalize bool
+}
+
+// CreditDebit is a convention for the sign of amounts marked CR or DR.
+type CreditDebit int
+
+const (
+	// NoCreditDebit doesn't allow amounts to be marked CR or DR.
+	NoCreditDebit CreditDebit = iota
+	// DebitNegative makes debits negative and credits positive, as on a bank statement.
+	DebitNegative
+	// CreditNegative makes credits negative and debits positive, as in a ledger of amounts owed.
+	CreditNegative
+)
+
+// NewWithOptions is like New, but accepts amounts in the forms allowed by opts.
+// It returns an error if cur is not well formed or not recognised, or if amt isn't in a form allowed by opts.
+func NewWithOptions(cur, amt string, opts ParseOptions) (Money, error) {
+	if opts.AllowAliases {
+		if a, ok := LookupAlias(cur); ok {
+			return newFromAlias(cur, a, amt, opts)
+		}
+	}
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	s, err := normalizeAmount(c, amt, opts)
+	if err != nil {
+		return Money{}, err
+	}
+	return newMoney(c, s)
+}
+
+// ParseWithOptions is like Parse, but accepts amounts in the forms allowed by opts.
+func ParseWithOptions(s string, opts ParseOptions) (Money, error) {
+	f := strings.Fields(s)
+	if len(f) == 3 && opts.CreditDebit != NoCreditDebit {
+		f = []string{f[0], f[1] + f[2]}
+	}
+	if len(f) != 2 {
+		return Money{}, fmt.Errorf("couldn't parse money: expected currency and amount, e.g. \"GBP 123.45\", got %q", s)
+	}
+	return NewWithOptions(f[0], f[1], opts)
+}
+
+// Canonical returns the canonical string form of an amount in the forms allowed by opts,
+// as returned by Money.String, e.g. "GBP 1.23" for ("GBP", " +0001.23 ") with Normalize.
+// It returns an error if cur is not well formed or not recognised, or if amt isn't in a form allowed by opts.
+func (opts ParseOptions) Canonical(cur, amt string) (string, error) {
+	x, err := NewWithOptions(cur, amt, opts)
+	if err != nil {
+		return "", err
+	}
+	return x.String(), nil
+}
+
+// ParseIssue describes an amount in a batch that couldn't be parsed.
+type ParseIssue struct {
+	// Index is the position of the amount in the batch.
+	Index int
+	Input string
+	Err   error
+}
+
+func (i ParseIssue) Error() string {
+	return fmt.Sprintf("amount %d (%q): %v", i.Index, i.Input, i.Err)
+}
+
+// ParseAll parses a batch of amounts in the currency cur, as New does, without stopping at the
+// first bad amount, so that an import can set bad rows aside and carry on.
+// The result has an element for each of amts, the zero Money for each amount that couldn't be
+// parsed, and an issue for each of those, in order. If cur isn't valid, every amount has an issue.
+func ParseAll(cur string, amts []string) ([]Money, []ParseIssue) {
+	return parseAll(amts, func(amt string) (Money, error) {
+		return New(cur, amt)
+	})
+}
+
+// ParseAll is like the ParseAll function, but accepts amounts in the forms allowed by opts.
+func (opts ParseOptions) ParseAll(cur string, amts []string) ([]Money, []ParseIssue) {
+	return parseAll(amts, func(amt string) (Money, error) {
+		return NewWithOptions(cur, amt, opts)
+	})
+}
+
+func parseAll(amts []string, parse func(string) (Money, error)) ([]Money, []ParseIssue) {
+	res := make([]Money, len(amts))
+	var issues []ParseIssue
+	for i, amt := range amts {
+		x, err := parse(amt)
+		if err != nil {
+			issues = append(issues, ParseIssue{i, amt, err})
+			continue
+		}
+		res[i] = x
+	}
+	return res, issues
+}
+
+// normalizeAmount rewrites amt, in a form allowed by opts, to the strict form accepted by New.
+// It returns an error if amt isn't in a form allowed by opts. The result is checked again by New.
+func normalizeAmount(c currency.Unit, amt string, opts ParseOptions) (s string, err error) {
+	s = amt
+	if opts.Normalize {
+		s = strings.TrimSpace(s)
+		opts.AllowLeadingPlus = true
+	}
+	// trailing is the sign given after the amount, if any.
+	trailing := ""
+	if opts.AllowTrailingSign && strings.HasSuffix(s, "-") {
+		trailing, s = "-", s[:len(s)-1]
+	} else if opts.CreditDebit != NoCreditDebit && len(s) > 2 {
+		switch mark := s[len(s)-2:]; {
+		case strings.EqualFold(mark, "CR"):
+			trailing = "+"
+			if opts.CreditDebit == CreditNegative {
+				trailing = "-"
+			}
+		case strings.EqualFold(mark, "DR"):
+			trailing = "+"
+			if opts.CreditDebit == DebitNegative {
+				trailing = "-"
+			}
+		}
+		if trailing != "" {
+			s = strings.TrimSuffix(s[:len(s)-2], " ")
+		}
+	}
+	parens := false
+	if opts.AllowParentheses && len(s) > 2 && s[0] == '(' && s[len(s)-1] == ')' {
+		parens, s = true, s[1:len(s)-1]
+	}
+	sign := ""
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+' && opts.AllowLeadingPlus) {
+		sign, s = s[:1], s[1:]
+	}
+	if opts.AllowSymbols {
+		if t, found := trimSymbol(c, s); found {
+			s = t
+			if sign == "" && len(s) > 0 && (s[0] == '-' || s[0] == '+' && opts.AllowLeadingPlus) {
+				sign, s = s[:1], s[1:]
+			}
+		}
+	}
+	if parens {
+		if sign != "" {
+			return "", badAmount(amt)
+		}
+		sign = "-"
+	}
+	if trailing != "" {
+		if sign != "" {
+			return "", badAmount(amt)
+		}
+		sign = trailing
+	}
+	if sign == "+" {
+		sign = ""
+	}
+	if opts.AllowExponent {
+		if t, ok, err := expandExponent(s, exponent(c)); err != nil {
+			return "", err
+		} else if ok {
+			return sign + t, nil
+		}
+	}
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if opts.AllowGrouping && strings.Contains(whole, ",") {
+		groups := strings.Split(whole, ",")
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return "", badAmount(amt)
+		}
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return "", badAmount(amt)
+			}
+		}
+		whole = strings.Join(groups, "")
+	}
+	if opts.Normalize && len(whole) > 1 {
+		whole = strings.TrimLeft(whole[:len(whole)-1], "0") + whole[len(whole)-1:]
+	}
+	if !hasPoint {
+		return sign + whole, nil
+	}
+	if opts.AllowMissingMinor {
+		if exp := exponent(c); len(frac) < exp {
+			frac += zeros[:exp-len(frac)]
+		} else if frac == "" {
+			return sign + whole, nil
+		}
+	}
+	return sign + whole + "." + frac, nil
+}
+
+// symbolPrinter is used to look up currency symbols for AllowSymbols.
+var symbolPrinter = message.NewPrinter(language.English)
+
+// trimSymbol removes c's symbol or narrow symbol from the start of s.
+func trimSymbol(c currency.Unit, s string) (string, bool) {
+	for _, sym := range []string{symbolPrinter.Sprint(currency.Symbol(c)), symbolPrinter.Sprint(currency.NarrowSymbol(c))} {
+		if strings.HasPrefix(s, sym) {
+			return s[len(sym):], true
+		}
+	}
+	return s, false
+}
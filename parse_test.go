@@ -0,0 +1,198 @@
+package dough
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCanParseWithOptions(t *testing.T) {
+	all := ParseOptions{AllowGrouping: true, AllowSymbols: true, AllowMissingMinor: true, AllowLeadingPlus: true}
+	var cases = []struct {
+		cur  string
+		amt  string
+		opts ParseOptions
+		want string
+	}{
+		{"GBP", "12.34", ParseOptions{}, "12.34"},
+		{"GBP", "1,234.56", ParseOptions{AllowGrouping: true}, "1234.56"},
+		{"GBP", "-1,234,567", ParseOptions{AllowGrouping: true}, "-1234567.00"},
+		{"GBP", "£12.34", ParseOptions{AllowSymbols: true}, "12.34"},
+		{"GBP", "-£12.34", ParseOptions{AllowSymbols: true}, "-12.34"},
+		{"GBP", "£-12.34", ParseOptions{AllowSymbols: true}, "-12.34"},
+		{"USD", "$5.00", ParseOptions{AllowSymbols: true}, "5.00"},
+		{"AUD", "A$5.00", ParseOptions{AllowSymbols: true}, "5.00"},
+		{"GBP", "12.5", ParseOptions{AllowMissingMinor: true}, "12.50"},
+		{"GBP", "12.", ParseOptions{AllowMissingMinor: true}, "12.00"},
+		{"JPY", "12.", ParseOptions{AllowMissingMinor: true}, "12"},
+		{"KWD", "1.2", ParseOptions{AllowMissingMinor: true}, "1.200"},
+		{"GBP", "+12.34", ParseOptions{AllowLeadingPlus: true}, "12.34"},
+		{"GBP", "+£1,234.5", all, "1234.50"},
+		{"GBP", "£+1,234.5", all, "1234.50"},
+		{"GBP", "(123.45)", ParseOptions{AllowParentheses: true}, "-123.45"},
+		{"GBP", "123.45", ParseOptions{AllowParentheses: true}, "123.45"},
+		{"GBP", "(£1,234.5)", ParseOptions{AllowParentheses: true, AllowGrouping: true, AllowSymbols: true, AllowMissingMinor: true}, "-1234.50"},
+		{"JPY", "(500)", ParseOptions{AllowParentheses: true}, "-500"},
+		{"GBP", "123.45-", ParseOptions{AllowTrailingSign: true}, "-123.45"},
+		{"GBP", "£1,234.50-", ParseOptions{AllowTrailingSign: true, AllowGrouping: true, AllowSymbols: true}, "-1234.50"},
+		{"GBP", "123.45 CR", ParseOptions{CreditDebit: DebitNegative}, "123.45"},
+		{"GBP", "123.45 DR", ParseOptions{CreditDebit: DebitNegative}, "-123.45"},
+		{"GBP", "123.45CR", ParseOptions{CreditDebit: CreditNegative}, "-123.45"},
+		{"GBP", "123.45 dr", ParseOptions{CreditDebit: CreditNegative}, "123.45"},
+		{"GBP", "123.45", ParseOptions{CreditDebit: CreditNegative}, "123.45"},
+	}
+	for _, c := range cases {
+		got, err := NewWithOptions(c.cur, c.amt, c.opts)
+		if err != nil {
+			t.Errorf("error received from NewWithOptions(%s, %s, %+v), none expected %v", c.cur, c.amt, c.opts, err)
+			continue
+		}
+		if got.Currency() != c.cur || got.Amount() != c.want {
+			t.Errorf("NewWithOptions(%s, %s, %+v): wanted %s %s, got %v", c.cur, c.amt, c.opts, c.cur, c.want, got)
+		}
+	}
+	if got, err := ParseWithOptions("GBP £1,000", all); err != nil || got.Amount() != "1000.00" {
+		t.Errorf("ParseWithOptions(GBP £1,000): wanted 1000.00, got %v (%v)", got, err)
+	}
+	if got, err := ParseWithOptions("GBP 12.00 DR", ParseOptions{CreditDebit: DebitNegative}); err != nil || got.Amount() != "-12.00" {
+		t.Errorf("ParseWithOptions(GBP 12.00 DR): wanted -12.00, got %v (%v)", got, err)
+	}
+}
+
+func TestCanRejectBadParseWithOptions(t *testing.T) {
+	all := ParseOptions{AllowGrouping: true, AllowSymbols: true, AllowMissingMinor: true, AllowLeadingPlus: true}
+	var cases = []struct {
+		cur  string
+		amt  string
+		opts ParseOptions
+	}{
+		{"GBP", "1,234.56", ParseOptions{}},
+		{"GBP", "£12.34", ParseOptions{}},
+		{"GBP", "12.5", ParseOptions{}},
+		{"GBP", "+12.34", ParseOptions{}},
+		{"GBP", "1,23.45", all},
+		{"GBP", "1234,567", all},
+		{"GBP", ",123", all},
+		{"GBP", "1,,234", all},
+		{"GBP", "$12.34", all},
+		{"GBP", "12.345", all},
+		{"GBP", "-£-12.34", all},
+		{"GBP", "--12.34", all},
+		{"GBP", "£", all},
+		{"GBP", "", all},
+		{"FOO", "12.34", all},
+		{"GBP", "(123.45)", all},
+		{"GBP", "(-123.45)", ParseOptions{AllowParentheses: true}},
+		{"GBP", "-(123.45)", ParseOptions{AllowParentheses: true}},
+		{"GBP", "(+123.45)", ParseOptions{AllowParentheses: true, AllowLeadingPlus: true}},
+		{"GBP", "(123.45", ParseOptions{AllowParentheses: true}},
+		{"GBP", "123.45)", ParseOptions{AllowParentheses: true}},
+		{"GBP", "()", ParseOptions{AllowParentheses: true}},
+		{"GBP", "((123.45))", ParseOptions{AllowParentheses: true}},
+		{"GBP", "123.45-", all},
+		{"GBP", "123.45 CR", all},
+		{"GBP", "-123.45-", ParseOptions{AllowTrailingSign: true}},
+		{"GBP", "(123.45)-", ParseOptions{AllowTrailingSign: true, AllowParentheses: true}},
+		{"GBP", "-", ParseOptions{AllowTrailingSign: true}},
+		{"GBP", "-123.45 DR", ParseOptions{CreditDebit: DebitNegative}},
+		{"GBP", "123.45 CR DR", ParseOptions{CreditDebit: DebitNegative}},
+		{"GBP", "123.45  CR", ParseOptions{CreditDebit: DebitNegative}},
+		{"GBP", "123.45 XR", ParseOptions{CreditDebit: DebitNegative}},
+	}
+	for _, c := range cases {
+		if got, err := NewWithOptions(c.cur, c.amt, c.opts); err == nil {
+			t.Errorf("error expected from NewWithOptions(%s, %s, %+v), none received, got %v", c.cur, c.amt, c.opts, got)
+		}
+	}
+	if _, err := ParseWithOptions("GBP", all); err == nil {
+		t.Errorf("error expected from ParseWithOptions(GBP), none received")
+	}
+	if _, err := ParseWithOptions("GBP 12.00 DR", all); err == nil {
+		t.Errorf("error expected from ParseWithOptions(GBP 12.00 DR) without CreditDebit, none received")
+	}
+}
+
+func TestCanNormalize(t *testing.T) {
+	norm := ParseOptions{Normalize: true}
+	var cases = []struct {
+		cur  string
+		amt  string
+		opts ParseOptions
+		want string
+	}{
+		{"GBP", "1.23", norm, "GBP 1.23"},
+		{"GBP", "+1.23", norm, "GBP 1.23"},
+		{"GBP", "0001.23", norm, "GBP 1.23"},
+		{"GBP", "-0001.23", norm, "GBP -1.23"},
+		{"GBP", "0.00", norm, "GBP 0.00"},
+		{"GBP", "000", norm, "GBP 0.00"},
+		{"GBP", " \t+0012.30\n", norm, "GBP 12.30"},
+		{"JPY", " 007 ", norm, "JPY 7"},
+		{"GBP", " (0012.30) ", ParseOptions{Normalize: true, AllowParentheses: true}, "GBP -12.30"},
+		{"GBP", " +£0,001.5 ", ParseOptions{Normalize: true, AllowGrouping: true, AllowSymbols: true, AllowMissingMinor: true}, "GBP 1.50"},
+	}
+	for _, c := range cases {
+		got, err := c.opts.Canonical(c.cur, c.amt)
+		if err != nil || got != c.want {
+			t.Errorf("Canonical(%s, %q): wanted %s, got %s (%v)", c.cur, c.amt, c.want, got, err)
+		}
+	}
+	var bad = []struct {
+		amt  string
+		opts ParseOptions
+	}{
+		{" 1.23", ParseOptions{}},
+		{"+1.23", ParseOptions{}},
+		{"1 .23", norm},
+		{"+ 1.23", norm},
+		{"++1.23", norm},
+		{"  ", norm},
+	}
+	for _, c := range bad {
+		if got, err := c.opts.Canonical("GBP", c.amt); err == nil {
+			t.Errorf("error expected from Canonical(GBP, %q, %+v), none received, got %s", c.amt, c.opts, got)
+		}
+	}
+}
+
+func TestCanParseAll(t *testing.T) {
+	var cases = []struct {
+		cur    string
+		amts   []string
+		opts   *ParseOptions
+		want   []string
+		issues []int
+	}{
+		{"GBP", []string{"1.00", "2.50"}, nil, []string{"GBP 1.00", "GBP 2.50"}, nil},
+		{"GBP", []string{"1.00", "abc", "2.5", "-0.01", ""}, nil, []string{"GBP 1.00", "", "", "GBP -0.01", ""}, []int{1, 2, 4}},
+		{"GBP", []string{"1.00", "abc", "2.5"}, &ParseOptions{AllowMissingMinor: true}, []string{"GBP 1.00", "", "GBP 2.50"}, []int{1}},
+		{"FOO", []string{"1.00", "2.00"}, nil, []string{"", ""}, []int{0, 1}},
+		{"GBP", nil, nil, []string{}, nil},
+	}
+	for _, c := range cases {
+		var got []Money
+		var issues []ParseIssue
+		if c.opts == nil {
+			got, issues = ParseAll(c.cur, c.amts)
+		} else {
+			got, issues = c.opts.ParseAll(c.cur, c.amts)
+		}
+		strs := make([]string, len(got))
+		for i, x := range got {
+			if x != (Money{}) {
+				strs[i] = x.String()
+			}
+		}
+		idx := []int(nil)
+		for _, is := range issues {
+			idx = append(idx, is.Index)
+			if is.Input != c.amts[is.Index] || is.Err == nil || !strings.Contains(is.Error(), fmt.Sprintf("amount %d", is.Index)) {
+				t.Errorf("ParseAll(%s, %q): bad issue %+v", c.cur, c.amts, is)
+			}
+		}
+		if !reflect.DeepEqual(strs, c.want) || !reflect.DeepEqual(idx, c.issues) {
+			t.Errorf("ParseAll(%s, %q): wanted %q with issues at %v, got %q with issues at %v", c.cur, c.amts, c.want, c.issues, strs, idx)
+		}
+	}
+}
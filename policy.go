@@ -0,0 +1,79 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeAmount is returned, wrapped, by a Factory whose Policy disallows negative amounts
+// when an operation would create one.
+var ErrNegativeAmount = errors.New("negative amount")
+
+// Policy constrains the Money values that a Factory creates.
+type Policy struct {
+	// DisallowNegative rejects any value less than zero, e.g. for gift card balances.
+	DisallowNegative bool
+}
+
+// Factory creates Money values and does arithmetic on them, enforcing a Policy on every result,
+// so that e.g. a negative balance is impossible rather than merely checked at call sites.
+// The zero value enforces no policy.
+type Factory struct {
+	policy Policy
+}
+
+// NewFactory returns a new Factory that enforces p.
+func NewFactory(p Policy) Factory {
+	return Factory{p}
+}
+
+// Policy gets the policy enforced by the Factory.
+func (f Factory) Policy() Policy {
+	return f.policy
+}
+
+// Check returns an error if x violates the Factory's policy.
+func (f Factory) Check(x Money) error {
+	if f.policy.DisallowNegative && x.a < 0 {
+		return fmt.Errorf("%w: %v", ErrNegativeAmount, x)
+	}
+	return nil
+}
+
+func (f Factory) checked(x Money, err error) (Money, error) {
+	if err != nil {
+		return Money{}, err
+	}
+	if err := f.Check(x); err != nil {
+		return Money{}, err
+	}
+	return x, nil
+}
+
+// New is like the package-level New, but also returns an error if the result violates the Factory's policy.
+func (f Factory) New(cur, amt string) (Money, error) {
+	return f.checked(New(cur, amt))
+}
+
+// FromMinorUnits is like the package-level FromMinorUnits, but also returns an error if the result violates the Factory's policy.
+func (f Factory) FromMinorUnits(cur string, units int64) (Money, error) {
+	return f.checked(FromMinorUnits(cur, units))
+}
+
+// Add returns x + y.
+// It returns an error if x and y are in different currencies, or the result violates the Factory's policy.
+func (f Factory) Add(x, y Money) (Money, error) {
+	return f.checked(x.Add(y))
+}
+
+// Sub returns x - y.
+// It returns an error if x and y are in different currencies, or the result violates the Factory's policy.
+func (f Factory) Sub(x, y Money) (Money, error) {
+	return f.checked(x.Sub(y))
+}
+
+// Mul returns x multiplied by factor.
+// It returns an error if the result violates the Factory's policy.
+func (f Factory) Mul(x Money, factor int) (Money, error) {
+	return f.checked(x.Mul(factor))
+}
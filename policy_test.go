@@ -0,0 +1,54 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanDisallowNegative(t *testing.T) {
+	f := NewFactory(Policy{DisallowNegative: true})
+	balance, err := f.New("GBP", "10.00")
+	if err != nil {
+		t.Fatalf("error received from New, none expected %v", err)
+	}
+	var cases = []struct {
+		name string
+		fn   func() (Money, error)
+		want string
+	}{
+		{"Sub to zero", func() (Money, error) { return f.Sub(balance, gbp("10.00")) }, "0.00"},
+		{"Add", func() (Money, error) { return f.Add(balance, gbp("-5.00")) }, "5.00"},
+		{"Mul", func() (Money, error) { return f.Mul(balance, 3) }, "30.00"},
+		{"FromMinorUnits", func() (Money, error) { return f.FromMinorUnits("GBP", 0) }, "0.00"},
+	}
+	for _, c := range cases {
+		if got, err := c.fn(); err != nil || got.Amount() != c.want {
+			t.Errorf("%s: wanted %s, got %v (%v)", c.name, c.want, got, err)
+		}
+	}
+	for name, fn := range map[string]func() (Money, error){
+		"New":            func() (Money, error) { return f.New("GBP", "-0.01") },
+		"FromMinorUnits": func() (Money, error) { return f.FromMinorUnits("GBP", -1) },
+		"Add":            func() (Money, error) { return f.Add(balance, gbp("-10.01")) },
+		"Sub":            func() (Money, error) { return f.Sub(balance, gbp("10.01")) },
+		"Mul":            func() (Money, error) { return f.Mul(balance, -1) },
+	} {
+		if got, err := fn(); !errors.Is(err, ErrNegativeAmount) {
+			t.Errorf("%s: wanted ErrNegativeAmount, got %v (%v)", name, got, err)
+		}
+	}
+	eur, _ := New("EUR", "1.00")
+	if _, err := f.Add(balance, eur); err == nil || errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("wanted currency error from Add, got %v", err)
+	}
+}
+
+func TestCanUseFactoryWithoutPolicy(t *testing.T) {
+	var f Factory
+	if got, err := f.Sub(gbp("1.00"), gbp("2.00")); err != nil || got.Amount() != "-1.00" {
+		t.Errorf("wanted -1.00, got %v (%v)", got, err)
+	}
+	if err := f.Check(gbp("-1.00")); err != nil {
+		t.Errorf("error received from Check, none expected %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// Precise is an amount of money with more decimal places than its currency's minor unit, e.g. £0.1299,
+// as used for fuel and telecom unit prices. Unlike a UnitPrice, it has a fixed number of decimal places,
+// and it can be added and multiplied to build up a total that is rounded only once, with Round, when it's
+// charged. The zero value is not a valid Precise.
+type Precise struct {
+	c currency.Unit
+	// u is the amount in units of 10^-places of a major unit.
+	u      *big.Int
+	places int
+}
+
+// NewPrecise returns a new Precise in the given currency, e.g. NewPrecise("GBP", "0.1299").
+// It has as many decimal places as amt, or as the currency's minor unit if that's more.
+// It returns an error if cur is not well formed or not recognised, or if amt is not a decimal number.
+func NewPrecise(cur, amt string) (Precise, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Precise{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	r, err := parseDecimal(amt)
+	if err != nil {
+		return Precise{}, err
+	}
+	_, frac, _ := strings.Cut(amt, ".")
+	places := max(len(frac), exponent(c))
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)))
+	return Precise{c, new(big.Int).Set(r.Num()), places}, nil
+}
+
+// PreciseOf returns x as a Precise with the currency's number of decimal places.
+func PreciseOf(x Money) Precise {
+	return Precise{x.c, big.NewInt(int64(x.a)), x.Exponent()}
+}
+
+// Currency gets the currency of the Precise.
+func (p Precise) Currency() string {
+	return p.c.String()
+}
+
+// Places returns the number of decimal places of the Precise.
+func (p Precise) Places() int {
+	return p.places
+}
+
+// Rat returns the exact amount in major units.
+func (p Precise) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(p.u, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p.places)), nil))
+}
+
+// Amount returns the amount as a decimal string with all its decimal places, e.g. "0.1299".
+func (p Precise) Amount() string {
+	return p.Rat().FloatString(p.places)
+}
+
+// String returns the currency code and amount, e.g. "GBP 0.1299".
+func (p Precise) String() string {
+	return p.Currency() + " " + p.Amount()
+}
+
+// Add returns the sum of p and q, with the decimal places of whichever has more.
+// It returns an error if they're in different currencies.
+func (p Precise) Add(q Precise) (Precise, error) {
+	if p.c != q.c {
+		return Precise{}, fmt.Errorf("Can't add different currencies (%s and %s)", p.Currency(), q.Currency())
+	}
+	places := max(p.places, q.places)
+	sum := new(big.Int).Add(p.rescale(places), q.rescale(places))
+	return Precise{p.c, sum, places}, nil
+}
+
+// rescale returns p's amount in units of 10^-places of a major unit, where places is at least p.places.
+func (p Precise) rescale(places int) *big.Int {
+	f := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places-p.places)), nil)
+	return f.Mul(f, p.u)
+}
+
+// Mul returns p multiplied by n, e.g. the price of n units, with the same decimal places.
+func (p Precise) Mul(n int) Precise {
+	return Precise{p.c, new(big.Int).Mul(p.u, big.NewInt(int64(n))), p.places}
+}
+
+// Round returns p rounded to its currency's minor unit using mode, e.g. to charge it.
+// It returns an error if the result can't be represented.
+func (p Precise) Round(mode RoundingMode) (Money, error) {
+	return fromRat(p.c, p.Rat(), mode)
+}
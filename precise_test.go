@@ -0,0 +1,72 @@
+package dough
+
+import "testing"
+
+func TestCanCreatePrecise(t *testing.T) {
+	var cases = []struct {
+		cur    string
+		amt    string
+		want   string
+		places int
+	}{
+		{"GBP", "0.1299", "GBP 0.1299", 4},
+		{"GBP", "0.10", "GBP 0.10", 2},
+		{"GBP", "3", "GBP 3.00", 2},
+		{"GBP", "-0.000001", "GBP -0.000001", 6},
+		{"JPY", "0.5", "JPY 0.5", 1},
+		{"KWD", "1.2", "KWD 1.200", 3},
+	}
+	for _, c := range cases {
+		got, err := NewPrecise(c.cur, c.amt)
+		if err != nil || got.String() != c.want || got.Places() != c.places {
+			t.Errorf("NewPrecise(%s, %s): wanted %s with %d places, got %v with %d (%v)", c.cur, c.amt, c.want, c.places, got, got.Places(), err)
+		}
+	}
+	for _, c := range []struct{ cur, amt string }{{"FOO", "1"}, {"GBP", ""}, {"GBP", "1e-4"}, {"GBP", "1.2.3"}} {
+		if got, err := NewPrecise(c.cur, c.amt); err == nil {
+			t.Errorf("error expected from NewPrecise(%s, %s), none received, got %v", c.cur, c.amt, got)
+		}
+	}
+	if got := PreciseOf(gbp("12.34")); got.String() != "GBP 12.34" || got.Places() != 2 {
+		t.Errorf("PreciseOf(GBP 12.34): wanted GBP 12.34, got %v", got)
+	}
+}
+
+func TestCanAccumulatePrecise(t *testing.T) {
+	price, _ := NewPrecise("GBP", "0.1299")
+	total := PreciseOf(gbp("0.00"))
+	for range 3 {
+		var err error
+		if total, err = total.Add(price.Mul(7)); err != nil {
+			t.Fatalf("error received from Add, none expected %v", err)
+		}
+	}
+	if total.String() != "GBP 2.7279" {
+		t.Errorf("3 × 7 × GBP 0.1299: wanted GBP 2.7279, got %v", total)
+	}
+	var cases = []struct {
+		mode RoundingMode
+		want string
+	}{
+		{HalfUp, "2.73"},
+		{Down, "2.72"},
+		{Floor, "2.72"},
+		{Up, "2.73"},
+	}
+	for _, c := range cases {
+		if got, err := total.Round(c.mode); err != nil || got.Amount() != c.want {
+			t.Errorf("rounding %v %v: wanted %s, got %v (%v)", total, c.mode, c.want, got, err)
+		}
+	}
+	if got, _ := price.Mul(-1).Round(HalfEven); got.Amount() != "-0.13" {
+		t.Errorf("rounding GBP -0.1299: wanted -0.13, got %v", got)
+	}
+	eur, _ := NewPrecise("EUR", "0.1299")
+	if _, err := price.Add(eur); err == nil {
+		t.Errorf("error expected from adding different currencies, none received")
+	}
+	huge, _ := NewPrecise("GBP", "100000000000000000000.0001")
+	if got, err := huge.Round(HalfUp); err == nil {
+		t.Errorf("error expected from rounding %v, none received, got %v", huge, got)
+	}
+}
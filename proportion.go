@@ -0,0 +1,90 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Proportion is an exact ratio, e.g. 20% or 1/3, for composing sequential percentages
+// without rounding at each step. Rounding happens once, when the Proportion is applied to Money.
+//
+// For example, 20% VAT followed by a 2.9% card fee on the VAT-inclusive amount is
+//
+//	vat, _ := NewPercent(20)
+//	fee, _ := NewPercent(2.9)
+//	feeOnNet := vat.Plus().Mul(fee) // 2.9% of 120% is 3.48%
+//
+// A fixed component, such as a £0.20 per-transaction fee, can be added to the rounded result
+// without rounding again, as it is already a whole number of minor units.
+//
+// The zero value is 0%.
+type Proportion struct {
+	r *big.Rat
+}
+
+// NewProportion returns r as a Proportion, e.g. 1/5 for 20%.
+func NewProportion(r *big.Rat) Proportion {
+	return Proportion{new(big.Rat).Set(r)}
+}
+
+// NewPercent returns p% as a Proportion. p is taken as the shortest decimal that represents it,
+// so NewPercent(2.9) is exactly 29/1000, not the nearest binary fraction.
+// It returns an error if p is NaN or infinite.
+func NewPercent(p float64) (Proportion, error) {
+	if math.IsNaN(p) || math.IsInf(p, 0) {
+		return Proportion{}, fmt.Errorf("invalid percentage: %v", p)
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(p, 'f', -1, 64))
+	return Proportion{r.Quo(r, big.NewRat(100, 1))}, nil
+}
+
+// Rat returns the Proportion as an exact ratio, e.g. 1/5 for 20%.
+func (p Proportion) Rat() *big.Rat {
+	if p.r == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(p.r)
+}
+
+// Add returns p + q, e.g. 20% + 5% is 25%.
+func (p Proportion) Add(q Proportion) Proportion {
+	r := p.Rat()
+	return Proportion{r.Add(r, q.Rat())}
+}
+
+// Mul returns p × q, e.g. 50% of 20% is 10%.
+func (p Proportion) Mul(q Proportion) Proportion {
+	r := p.Rat()
+	return Proportion{r.Mul(r, q.Rat())}
+}
+
+// Plus returns 1 + p, the Proportion that applies p on top of an amount, e.g. 120% for 20%.
+func (p Proportion) Plus() Proportion {
+	r := p.Rat()
+	return Proportion{r.Add(r, big.NewRat(1, 1))}
+}
+
+// Of returns p of x, rounded once to the currency's minor unit using mode.
+// It returns an error if the result can't be represented.
+func (p Proportion) Of(x Money, mode RoundingMode) (Money, error) {
+	return fromRat(x.c, p.Rat().Mul(p.Rat(), x.Rat()), mode)
+}
+
+// String returns the Proportion as a percentage, e.g. "3.48%".
+// Recurring decimals are rounded to 20 places.
+func (p Proportion) String() string {
+	r := p.Rat()
+	return decimalString(r.Mul(r, big.NewRat(100, 1))) + "%"
+}
+
+// decimalString returns r as a decimal with as few places as needed, up to 20, e.g. "1.25" or "150".
+func decimalString(r *big.Rat) string {
+	places := 0
+	t := new(big.Rat).Set(r)
+	for ; !t.IsInt() && places < maxUnitPricePlaces; places++ {
+		t.Mul(t, big.NewRat(10, 1))
+	}
+	return r.FloatString(places)
+}
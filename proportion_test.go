@@ -0,0 +1,76 @@
+package dough
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCanComposeProportions(t *testing.T) {
+	vat, _ := NewPercent(20)
+	fee, _ := NewPercent(2.9)
+	half := NewProportion(big.NewRat(1, 2))
+	third := NewProportion(big.NewRat(1, 3))
+	var cases = []struct {
+		p    Proportion
+		want string
+	}{
+		{vat, "20%"},
+		{fee, "2.9%"},
+		{vat.Plus(), "120%"},
+		{vat.Plus().Mul(fee), "3.48%"},
+		{vat.Add(fee), "22.9%"},
+		{half.Mul(vat), "10%"},
+		{third, "33.33333333333333333333%"},
+		{third.Add(third).Add(third), "100%"},
+		{Proportion{}, "0%"},
+		{Proportion{}.Plus(), "100%"},
+	}
+	for _, c := range cases {
+		if got := c.p.String(); got != c.want {
+			t.Errorf("wanted %s, got %s", c.want, got)
+		}
+	}
+	if r := fee.Rat(); r.Cmp(big.NewRat(29, 1000)) != 0 {
+		t.Errorf("NewPercent(2.9).Rat(): wanted 29/1000, got %s", r.RatString())
+	}
+}
+
+func TestCanApplyProportion(t *testing.T) {
+	vat, _ := NewPercent(20)
+	fee, _ := NewPercent(2.9)
+	var cases = []struct {
+		p    Proportion
+		x    Money
+		mode RoundingMode
+		want Money
+	}{
+		{vat, gbp("10.00"), HalfUp, gbp("2.00")},
+		{vat.Plus(), gbp("10.00"), HalfUp, gbp("12.00")},
+		{vat.Plus().Mul(fee), gbp("10.34"), HalfUp, gbp("0.36")},
+		// Rounding in between would give 2.9% of 52p, i.e. 2p, but 3.48% of 43p is 1.4964p.
+		{vat.Plus().Mul(fee), gbp("0.43"), HalfUp, gbp("0.01")},
+		{vat.Plus().Mul(fee), gbp("10.33"), Down, gbp("0.35")},
+		{NewProportion(big.NewRat(1, 3)), gbp("1.00"), HalfUp, gbp("0.33")},
+		{NewProportion(big.NewRat(1, 3)), gbp("-1.00"), Floor, gbp("-0.34")},
+		{Proportion{}, gbp("1.00"), HalfUp, gbp("0.00")},
+	}
+	for _, c := range cases {
+		got, err := c.p.Of(c.x, c.mode)
+		if err != nil || got != c.want {
+			t.Errorf("%v of %v (%v): wanted %v, got %v (%v)", c.p, c.x, c.mode, c.want, got, err)
+		}
+	}
+	hi, _ := MaxValue("GBP")
+	if _, err := vat.Plus().Of(hi, HalfUp); err == nil {
+		t.Errorf("error expected applying 120%% to MaxValue, none received")
+	}
+}
+
+func TestCanRejectBadPercent(t *testing.T) {
+	for _, p := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewPercent(p); err == nil {
+			t.Errorf("error expected from NewPercent(%v), none received", p)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Proration is the result of changing plan part way through a billing period, calculated by Prorate.
+// The legs always reconcile exactly: Used + Credit equals the old plan's price, and Net is Charge - Credit.
+type Proration struct {
+	// Used is the part of the old plan's price for the time already used.
+	Used Money
+	// Credit is the part of the old plan's price for the time left unused, to be credited.
+	Credit Money
+	// Charge is the new plan's price for the rest of the period.
+	Charge Money
+	// Net is the amount due for the change, i.e. Charge - Credit. It is negative if a refund is due.
+	Net Money
+}
+
+// Prorate calculates the credit and charge for switching from a plan costing oldPrice per period
+// to one costing newPrice per period, with remaining of the period left. Used and Charge are
+// calculated in proportion to the time used and remaining, and rounded with mode.
+// Credit is whatever of oldPrice isn't Used, so nothing is lost to rounding.
+// It returns an error if the prices are negative or in different currencies, period isn't positive,
+// or remaining isn't between zero and period.
+func Prorate(oldPrice, newPrice Money, period, remaining time.Duration, mode RoundingMode) (Proration, error) {
+	if oldPrice.Currency() != newPrice.Currency() {
+		return Proration{}, fmt.Errorf("Can't prorate different currencies. Old plan is %s, new plan is %s", oldPrice.Currency(), newPrice.Currency())
+	}
+	if oldPrice.a < 0 || newPrice.a < 0 {
+		return Proration{}, fmt.Errorf("can't prorate negative prices %v and %v", oldPrice, newPrice)
+	}
+	if period <= 0 {
+		return Proration{}, fmt.Errorf("billing period must be positive, got %v", period)
+	}
+	if remaining < 0 || remaining > period {
+		return Proration{}, fmt.Errorf("remaining time must be between 0 and %v, got %v", period, remaining)
+	}
+	used, err := roundAtoms(new(big.Rat).Mul(big.NewRat(int64(oldPrice.a), 1), big.NewRat(int64(period-remaining), int64(period))), mode)
+	if err != nil {
+		return Proration{}, err
+	}
+	charge, err := roundAtoms(new(big.Rat).Mul(big.NewRat(int64(newPrice.a), 1), big.NewRat(int64(remaining), int64(period))), mode)
+	if err != nil {
+		return Proration{}, err
+	}
+	credit := oldPrice.a - used
+	return Proration{
+		Used:   Money{oldPrice.c, used},
+		Credit: Money{oldPrice.c, credit},
+		Charge: Money{oldPrice.c, charge},
+		Net:    Money{oldPrice.c, charge - credit},
+	}, nil
+}
@@ -0,0 +1,61 @@
+package dough
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanProrate(t *testing.T) {
+	const day = 24 * time.Hour
+	var cases = []struct {
+		old, new_ string
+		period    time.Duration
+		remaining time.Duration
+		mode      RoundingMode
+		used      string
+		credit    string
+		charge    string
+		net       string
+	}{
+		{"30.00", "60.00", 30 * day, 15 * day, HalfUp, "15.00", "15.00", "30.00", "15.00"},
+		{"60.00", "30.00", 30 * day, 10 * day, HalfUp, "40.00", "20.00", "10.00", "-10.00"},
+		{"10.00", "20.00", 31 * day, 10 * day, HalfUp, "6.77", "3.23", "6.45", "3.22"},
+		{"10.00", "20.00", 31 * day, 10 * day, Down, "6.77", "3.23", "6.45", "3.22"},
+		{"10.00", "20.00", 31 * day, 10 * day, Up, "6.78", "3.22", "6.46", "3.24"},
+		{"9.99", "19.99", 30 * day, 30 * day, HalfUp, "0.00", "9.99", "19.99", "10.00"},
+		{"9.99", "19.99", 30 * day, 0, HalfUp, "9.99", "0.00", "0.00", "0.00"},
+	}
+	for _, c := range cases {
+		got, err := Prorate(gbp(c.old), gbp(c.new_), c.period, c.remaining, c.mode)
+		if err != nil {
+			t.Errorf("error received from Prorate(%s, %s, %v, %v), none expected %v", c.old, c.new_, c.period, c.remaining, err)
+			continue
+		}
+		if got.Used.Amount() != c.used || got.Credit.Amount() != c.credit || got.Charge.Amount() != c.charge || got.Net.Amount() != c.net {
+			t.Errorf("Prorate(%s, %s, %v, %v, %v): wanted used %s, credit %s, charge %s, net %s, got %s, %s, %s, %s", c.old, c.new_, c.period, c.remaining, c.mode, c.used, c.credit, c.charge, c.net, got.Used.Amount(), got.Credit.Amount(), got.Charge.Amount(), got.Net.Amount())
+		}
+		if got.Used.a+got.Credit.a != gbp(c.old).a || got.Charge.a-got.Credit.a != got.Net.a {
+			t.Errorf("Prorate(%s, %s, %v, %v): legs don't reconcile: %+v", c.old, c.new_, c.period, c.remaining, got)
+		}
+	}
+}
+
+func TestCanRejectBadProration(t *testing.T) {
+	eur, _ := New("EUR", "10.00")
+	var cases = []struct {
+		old, new_ Money
+		period    time.Duration
+		remaining time.Duration
+	}{
+		{gbp("10.00"), eur, time.Hour, time.Minute},
+		{gbp("-10.00"), gbp("10.00"), time.Hour, time.Minute},
+		{gbp("10.00"), gbp("10.00"), 0, 0},
+		{gbp("10.00"), gbp("10.00"), time.Hour, -time.Minute},
+		{gbp("10.00"), gbp("10.00"), time.Hour, 2 * time.Hour},
+	}
+	for _, c := range cases {
+		if got, err := Prorate(c.old, c.new_, c.period, c.remaining, HalfUp); err == nil {
+			t.Errorf("error expected from Prorate(%v, %v, %v, %v), none received, got %+v", c.old, c.new_, c.period, c.remaining, got)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package dough
+
+import (
+	"fmt"
+	"iter"
+	"math"
+)
+
+// Range is an inclusive interval of Money values in a single currency,
+// e.g. a price band or a shipping-fee bracket.
+type Range struct {
+	From Money
+	To   Money
+}
+
+// NewRange returns a new Range from from to to, inclusive.
+// It returns an error if the currencies differ, or if from is greater than to.
+func NewRange(from, to Money) (Range, error) {
+	c, err := from.Cmp(to)
+	if err != nil {
+		return Range{}, err
+	}
+	if c > 0 {
+		return Range{}, fmt.Errorf("invalid range: %s %s is greater than %s %s", from.Currency(), from.Amount(), to.Currency(), to.Amount())
+	}
+	return Range{
+		From: from,
+		To:   to,
+	}, nil
+}
+
+// Currency gets the currency of the Range.
+func (r Range) Currency() string {
+	return r.From.Currency()
+}
+
+// Contains reports whether m lies within the range.
+// It returns an error if m is in a different currency.
+func (r Range) Contains(m Money) (bool, error) {
+	lo, err := r.From.Cmp(m)
+	if err != nil {
+		return false, err
+	}
+	hi, err := r.To.Cmp(m)
+	if err != nil {
+		return false, err
+	}
+	return lo <= 0 && hi >= 0, nil
+}
+
+// Overlaps reports whether r and s have at least one value in common.
+// It returns an error if the ranges are in different currencies.
+func (r Range) Overlaps(s Range) (bool, error) {
+	_, ok, err := r.Intersect(s)
+	return ok, err
+}
+
+// Clamp returns m limited to the bounds of the range.
+// It returns an error if m is in a different currency.
+func (r Range) Clamp(m Money) (Money, error) {
+	if c, err := r.From.Cmp(m); err != nil {
+		return Money{}, err
+	} else if c > 0 {
+		return r.From, nil
+	}
+	if c, _ := r.To.Cmp(m); c < 0 {
+		return r.To, nil
+	}
+	return m, nil
+}
+
+// Intersect returns the range of values common to r and s.
+// ok is false if the ranges do not overlap.
+// It returns an error if the ranges are in different currencies.
+func (r Range) Intersect(s Range) (i Range, ok bool, err error) {
+	from, to := r.From, r.To
+	c, err := from.Cmp(s.From)
+	if err != nil {
+		return Range{}, false, err
+	}
+	if c < 0 {
+		from = s.From
+	}
+	if c, _ := to.Cmp(s.To); c > 0 {
+		to = s.To
+	}
+	if c, _ := from.Cmp(to); c > 0 {
+		return Range{}, false, nil
+	}
+	return Range{
+		From: from,
+		To:   to,
+	}, true, nil
+}
+
+// RangeIter returns an iterator over the amounts from from to to inclusive, in increments of step,
+// e.g. a price ladder from £1.00 to £2.00 in steps of £0.25. A negative step counts down.
+// The last amount yielded is the last one not beyond to, which is to itself only if step divides
+// the difference exactly. Nothing is yielded if step points away from to.
+// It returns an error if the amounts are in different currencies, or step is zero.
+func RangeIter(from, to, step Money) (iter.Seq[Money], error) {
+	if from.Currency() != to.Currency() || from.Currency() != step.Currency() {
+		return nil, fmt.Errorf("Can't iterate over different currencies (%s to %s in steps of %s)", from.Currency(), to.Currency(), step.Currency())
+	}
+	if step.a == 0 {
+		return nil, fmt.Errorf("step must not be zero")
+	}
+	return func(yield func(Money) bool) {
+		for a := from.a; step.a > 0 && a <= to.a || step.a < 0 && a >= to.a; a += step.a {
+			if !yield(Money{from.c, a}) {
+				return
+			}
+			if step.a > 0 && a > math.MaxInt-step.a || step.a < 0 && a < math.MinInt-step.a {
+				return
+			}
+		}
+	}, nil
+}
+
+// Iter returns an iterator over the amounts in the range, from From to To in increments of step.
+// It is equivalent to RangeIter(r.From, r.To, step), except that step must be positive.
+func (r Range) Iter(step Money) (iter.Seq[Money], error) {
+	if step.a <= 0 {
+		return nil, fmt.Errorf("step must be positive, got %v", step)
+	}
+	return RangeIter(r.From, r.To, step)
+}
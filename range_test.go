@@ -0,0 +1,190 @@
+package dough
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func newRange(t *testing.T, from, to string) Range {
+	a, _ := New("GBP", from)
+	b, _ := New("GBP", to)
+	r, err := NewRange(a, b)
+	if err != nil {
+		t.Fatalf("error received from NewRange(%s, %s), none expected %v", from, to, err)
+	}
+	return r
+}
+
+func TestCanRejectBadRange(t *testing.T) {
+	var cases = []struct {
+		ac string
+		a  string
+		bc string
+		b  string
+	}{
+		{"GBP", "1.00", "GBP", "0.99"},
+		{"GBP", "0.00", "GBP", "-0.01"},
+		{"GBP", "1.00", "EUR", "2.00"},
+	}
+	for _, c := range cases {
+		a, _ := New(c.ac, c.a)
+		b, _ := New(c.bc, c.b)
+		if _, err := NewRange(a, b); err == nil {
+			t.Errorf("error expected from NewRange(%s %s, %s %s), none received", c.ac, c.a, c.bc, c.b)
+		}
+	}
+}
+
+func TestCanCheckRangeContains(t *testing.T) {
+	var cases = []struct {
+		from string
+		to   string
+		m    string
+		want bool
+	}{
+		{"1.00", "2.00", "1.50", true},
+		{"1.00", "2.00", "1.00", true},
+		{"1.00", "2.00", "2.00", true},
+		{"1.00", "2.00", "0.99", false},
+		{"1.00", "2.00", "2.01", false},
+		{"-2.00", "-1.00", "-1.50", true},
+		{"0.00", "0.00", "0.00", true},
+	}
+	for _, c := range cases {
+		r := newRange(t, c.from, c.to)
+		m, _ := New("GBP", c.m)
+		if got, _ := r.Contains(m); got != c.want {
+			t.Errorf("[%s, %s] contains %s: wanted %v, got %v", c.from, c.to, c.m, c.want, got)
+		}
+	}
+}
+
+func TestCanClampToRange(t *testing.T) {
+	var cases = []struct {
+		from string
+		to   string
+		m    string
+		want string
+	}{
+		{"1.00", "2.00", "1.50", "1.50"},
+		{"1.00", "2.00", "0.50", "1.00"},
+		{"1.00", "2.00", "2.50", "2.00"},
+		{"-2.00", "-1.00", "0.00", "-1.00"},
+	}
+	for _, c := range cases {
+		r := newRange(t, c.from, c.to)
+		m, _ := New("GBP", c.m)
+		if got, _ := r.Clamp(m); got.Amount() != c.want {
+			t.Errorf("clamping %s to [%s, %s]: wanted %s, got %s", c.m, c.from, c.to, c.want, got.Amount())
+		}
+	}
+}
+
+func TestCanIntersectRanges(t *testing.T) {
+	var cases = []struct {
+		a    [2]string
+		b    [2]string
+		ok   bool
+		want [2]string
+	}{
+		{[2]string{"1.00", "3.00"}, [2]string{"2.00", "4.00"}, true, [2]string{"2.00", "3.00"}},
+		{[2]string{"2.00", "4.00"}, [2]string{"1.00", "3.00"}, true, [2]string{"2.00", "3.00"}},
+		{[2]string{"1.00", "4.00"}, [2]string{"2.00", "3.00"}, true, [2]string{"2.00", "3.00"}},
+		{[2]string{"1.00", "2.00"}, [2]string{"2.00", "3.00"}, true, [2]string{"2.00", "2.00"}},
+		{[2]string{"1.00", "2.00"}, [2]string{"2.01", "3.00"}, false, [2]string{}},
+	}
+	for _, c := range cases {
+		a := newRange(t, c.a[0], c.a[1])
+		b := newRange(t, c.b[0], c.b[1])
+		got, ok, err := a.Intersect(b)
+		if err != nil {
+			t.Errorf("error received intersecting %v and %v, none expected %v", c.a, c.b, err)
+		}
+		if ok != c.ok {
+			t.Errorf("intersecting %v and %v: wanted ok=%v, got %v", c.a, c.b, c.ok, ok)
+		}
+		if ov, _ := a.Overlaps(b); ov != c.ok {
+			t.Errorf("overlap of %v and %v: wanted %v, got %v", c.a, c.b, c.ok, ov)
+		}
+		if ok && (got.From.Amount() != c.want[0] || got.To.Amount() != c.want[1]) {
+			t.Errorf("intersecting %v and %v: wanted %v, got [%s, %s]", c.a, c.b, c.want, got.From.Amount(), got.To.Amount())
+		}
+	}
+}
+
+func TestCanRejectMismatchedCurrencyInRange(t *testing.T) {
+	r := newRange(t, "1.00", "2.00")
+	m, _ := New("EUR", "1.50")
+	if _, err := r.Contains(m); err == nil {
+		t.Errorf("error expected checking EUR within GBP range, none received")
+	}
+	if _, err := r.Clamp(m); err == nil {
+		t.Errorf("error expected clamping EUR to GBP range, none received")
+	}
+	s, _ := NewRange(m, m)
+	if _, err := r.Overlaps(s); err == nil {
+		t.Errorf("error expected checking overlap of EUR and GBP ranges, none received")
+	}
+}
+
+func TestCanIterateRange(t *testing.T) {
+	var cases = []struct {
+		from, to, step string
+		want           []string
+	}{
+		{"1.00", "2.00", "0.25", []string{"1.00", "1.25", "1.50", "1.75", "2.00"}},
+		{"1.00", "2.00", "0.30", []string{"1.00", "1.30", "1.60", "1.90"}},
+		{"1.00", "1.00", "0.01", []string{"1.00"}},
+		{"2.00", "1.00", "-0.50", []string{"2.00", "1.50", "1.00"}},
+		{"2.00", "1.00", "0.50", nil},
+		{"-0.02", "0.02", "0.02", []string{"-0.02", "0.00", "0.02"}},
+	}
+	for _, c := range cases {
+		seq, err := RangeIter(gbp(c.from), gbp(c.to), gbp(c.step))
+		if err != nil {
+			t.Errorf("error received from RangeIter(%s, %s, %s), none expected %v", c.from, c.to, c.step, err)
+			continue
+		}
+		var got []string
+		for m := range seq {
+			got = append(got, m.Amount())
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("RangeIter(%s, %s, %s): wanted %v, got %v", c.from, c.to, c.step, c.want, got)
+		}
+	}
+}
+
+func TestCanStopIteratingRange(t *testing.T) {
+	seq, _ := RangeIter(Money{gbp("0.00").c, math.MaxInt - 1}, Money{gbp("0.00").c, math.MaxInt}, gbp("0.01"))
+	n := 0
+	for range seq {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("wanted 2 amounts up to the maximum, got %d", n)
+	}
+	seq, _ = newRange(t, "0.00", "100.00").Iter(gbp("0.01"))
+	n = 0
+	for range seq {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("wanted to stop after 3 amounts, got %d", n)
+	}
+}
+
+func TestCanRejectBadRangeIter(t *testing.T) {
+	if _, err := RangeIter(gbp("1.00"), gbp("2.00"), MustNew("EUR", "0.01")); err == nil {
+		t.Errorf("error expected from RangeIter with different currencies, none received")
+	}
+	if _, err := RangeIter(gbp("1.00"), gbp("2.00"), gbp("0.00")); err == nil {
+		t.Errorf("error expected from RangeIter with zero step, none received")
+	}
+	if _, err := newRange(t, "1.00", "2.00").Iter(gbp("-0.01")); err == nil {
+		t.Errorf("error expected from Range.Iter with negative step, none received")
+	}
+}
@@ -0,0 +1,88 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// Rate is an exact exchange rate from one currency to another: the amount of the quote currency,
+// in major units, that one major unit of the base currency buys. Unlike a float64, a Rate can be
+// inverted and chained without drift. The zero value is not a valid Rate.
+type Rate struct {
+	from, to currency.Unit
+	r        *big.Rat
+}
+
+// NewRate returns the Rate from one currency to another, e.g. NewRate("GBP", "USD", big.NewRat(5, 4)).
+// It returns an error if either currency is not well formed or not recognised, or r isn't positive.
+func NewRate(from, to string, r *big.Rat) (Rate, error) {
+	f, err := currency.ParseISO(from)
+	if err != nil {
+		return Rate{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	t, err := currency.ParseISO(to)
+	if err != nil {
+		return Rate{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if r == nil || r.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("invalid rate from %s to %s: %v", from, to, r)
+	}
+	return Rate{f, t, new(big.Rat).Set(r)}, nil
+}
+
+// ParseRate is like NewRate, but takes the rate as a decimal string, e.g. "1.2534".
+func ParseRate(from, to, s string) (Rate, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return Rate{}, err
+	}
+	return NewRate(from, to, r)
+}
+
+// From returns the base currency of the Rate.
+func (r Rate) From() string {
+	return r.from.String()
+}
+
+// To returns the quote currency of the Rate.
+func (r Rate) To() string {
+	return r.to.String()
+}
+
+// Rat returns the Rate as an exact ratio.
+func (r Rate) Rat() *big.Rat {
+	return new(big.Rat).Set(r.r)
+}
+
+// Invert returns the Rate in the other direction, e.g. USD/GBP 0.8 for GBP/USD 1.25.
+// It is exact, so r.Invert().Invert() equals r.
+func (r Rate) Invert() Rate {
+	return Rate{r.to, r.from, new(big.Rat).Inv(r.r)}
+}
+
+// Compose returns the Rate for converting with r then s, e.g. GBP/JPY from GBP/USD and USD/JPY.
+// It returns an error if s doesn't start from r's quote currency.
+func (r Rate) Compose(s Rate) (Rate, error) {
+	if r.to != s.from {
+		return Rate{}, fmt.Errorf("Can't compose %s/%s with %s/%s", r.From(), r.To(), s.From(), s.To())
+	}
+	return Rate{r.from, s.to, new(big.Rat).Mul(r.r, s.r)}, nil
+}
+
+// Apply converts x to the quote currency, rounding to that currency's minor unit using mode,
+// e.g. GBP 1.00 at GBP/JPY 187.5 is JPY 188, rounding half up.
+// It returns an error if x isn't in the base currency, or the result can't be represented.
+func (r Rate) Apply(x Money, mode RoundingMode) (Money, error) {
+	if x.c != r.from {
+		return Money{}, fmt.Errorf("Can't apply %s/%s rate to %s", r.From(), r.To(), x.Currency())
+	}
+	return fromRat(r.to, new(big.Rat).Mul(x.Rat(), r.r), mode)
+}
+
+// String returns the currency pair and rate, e.g. "GBP/USD 1.25".
+// Recurring decimals are rounded to 20 places.
+func (r Rate) String() string {
+	return r.From() + "/" + r.To() + " " + decimalString(r.r)
+}
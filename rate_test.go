@@ -0,0 +1,94 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanInvertAndComposeRates(t *testing.T) {
+	gbpusd, _ := ParseRate("GBP", "USD", "1.25")
+	usdjpy, _ := ParseRate("USD", "JPY", "150")
+	eurusd, _ := ParseRate("EUR", "USD", "1.1")
+	gbpjpy, err := gbpusd.Compose(usdjpy)
+	if err != nil {
+		t.Fatalf("error received from Compose, none expected %v", err)
+	}
+	gbpeur, _ := gbpusd.Compose(eurusd.Invert())
+	var cases = []struct {
+		r    Rate
+		want string
+	}{
+		{gbpusd, "GBP/USD 1.25"},
+		{gbpusd.Invert(), "USD/GBP 0.8"},
+		{gbpusd.Invert().Invert(), "GBP/USD 1.25"},
+		{gbpjpy, "GBP/JPY 187.5"},
+		{gbpjpy.Invert(), "JPY/GBP 0.00533333333333333333"},
+		{eurusd.Invert(), "USD/EUR 0.90909090909090909091"},
+		{gbpeur, "GBP/EUR 1.13636363636363636364"},
+	}
+	for _, c := range cases {
+		if got := c.r.String(); got != c.want {
+			t.Errorf("wanted %s, got %s", c.want, got)
+		}
+	}
+	// Inverting and chaining are exact: there and back is exactly 1.
+	round, _ := gbpeur.Compose(eurusd)
+	round, _ = round.Compose(gbpusd.Invert())
+	if round.Rat().Cmp(big.NewRat(1, 1)) != 0 || round.From() != "GBP" || round.To() != "GBP" {
+		t.Errorf("wanted GBP/GBP 1, got %v", round)
+	}
+	if _, err := gbpusd.Compose(gbpusd); err == nil {
+		t.Errorf("error expected composing GBP/USD with GBP/USD, none received")
+	}
+}
+
+func TestCanApplyRate(t *testing.T) {
+	gbpusd, _ := ParseRate("GBP", "USD", "1.25")
+	usdjpy, _ := ParseRate("USD", "JPY", "150")
+	usdkwd, _ := ParseRate("USD", "KWD", "0.3075")
+	gbpjpy, _ := gbpusd.Compose(usdjpy)
+	var cases = []struct {
+		r    Rate
+		x    Money
+		mode RoundingMode
+		want string
+	}{
+		{gbpusd, MustNew("GBP", "100.00"), HalfUp, "USD 125.00"},
+		{gbpusd.Invert(), MustNew("USD", "0.01"), HalfUp, "GBP 0.01"},
+		{gbpusd.Invert(), MustNew("USD", "0.01"), Down, "GBP 0.00"},
+		{gbpjpy, MustNew("GBP", "1.00"), HalfUp, "JPY 188"},
+		{gbpjpy, MustNew("GBP", "1.00"), HalfEven, "JPY 188"},
+		{gbpjpy, MustNew("GBP", "1.00"), Down, "JPY 187"},
+		{usdkwd, MustNew("USD", "1.00"), HalfUp, "KWD 0.308"},
+		{gbpjpy.Invert(), MustNew("JPY", "1000"), HalfUp, "GBP 5.33"},
+	}
+	for _, c := range cases {
+		got, err := c.r.Apply(c.x, c.mode)
+		if err != nil || got.String() != c.want {
+			t.Errorf("%v.Apply(%v, %v): wanted %s, got %v (%v)", c.r, c.x, c.mode, c.want, got, err)
+		}
+	}
+	if _, err := gbpusd.Apply(MustNew("USD", "1.00"), HalfUp); err == nil {
+		t.Errorf("error expected applying GBP/USD to USD, none received")
+	}
+}
+
+func TestCanRejectBadRate(t *testing.T) {
+	var cases = []struct {
+		from, to, r string
+	}{
+		{"FOO", "USD", "1"},
+		{"GBP", "FOO", "1"},
+		{"GBP", "USD", "0"},
+		{"GBP", "USD", "-1.25"},
+		{"GBP", "USD", "abc"},
+	}
+	for _, c := range cases {
+		if got, err := ParseRate(c.from, c.to, c.r); err == nil {
+			t.Errorf("error expected from ParseRate(%s, %s, %s), none received, got %v", c.from, c.to, c.r, got)
+		}
+	}
+	if _, err := NewRate("GBP", "USD", nil); err == nil {
+		t.Errorf("error expected from NewRate with nil rate, none received")
+	}
+}
@@ -0,0 +1,100 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// RateProvider supplies exchange rates between currencies.
+type RateProvider interface {
+	// Rate returns the exchange rate from one currency to another, i.e. the amount of to in
+	// major units that one major unit of from buys. It returns an error if no rate is available.
+	Rate(from, to string) (*big.Rat, error)
+}
+
+// RateFunc is an adapter to allow the use of ordinary functions as RateProviders.
+type RateFunc func(from, to string) (*big.Rat, error)
+
+// Rate calls f(from, to).
+func (f RateFunc) Rate(from, to string) (*big.Rat, error) {
+	return f(from, to)
+}
+
+// Convert converts x to the currency to, using the rate from rates, and rounds the result using mode.
+// Converting to x's own currency returns x without consulting rates.
+// Pass Default to use the package's default rounding mode; see DefaultRounding.
+// It returns an error if to is not well formed or not recognised, if rates has no rate,
+// or if the result can't be represented.
+func Convert(x Money, to string, rates RateProvider, mode RoundingMode) (Money, error) {
+	c, err := currency.ParseISO(to)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if c == x.c {
+		return x, nil
+	}
+	r, err := rates.Rate(x.Currency(), to)
+	if err != nil {
+		return Money{}, err
+	}
+	if r == nil || r.Sign() <= 0 {
+		return Money{}, fmt.Errorf("invalid rate from %s to %s: %v", x.Currency(), to, r)
+	}
+	return fromRat(c, new(big.Rat).Mul(x.Rat(), r), mode)
+}
+
+// ConversionPreview pairs an amount to send with the amount received, and checks the pair by
+// converting back, so that a "you send X, they receive Y" display reconciles.
+type ConversionPreview struct {
+	Send    Money
+	Receive Money
+	// Back is Receive converted back at the inverse rate, rounded using the same mode.
+	Back Money
+	// Lossy reports whether converting Receive back exactly doesn't give Send,
+	// i.e. whether rounding Receive lost part of Send.
+	Lossy bool
+}
+
+// PreviewConversion converts x to the currency to at rate, the amount of to in major units that
+// one major unit of x's currency buys, rounding using mode, and converts the result back.
+// It returns an error if to is not well formed or not recognised, if rate isn't positive,
+// or if a result can't be represented.
+func PreviewConversion(x Money, to string, rate *big.Rat, mode RoundingMode) (ConversionPreview, error) {
+	c, err := currency.ParseISO(to)
+	if err != nil {
+		return ConversionPreview{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return ConversionPreview{}, fmt.Errorf("invalid rate from %s to %s: %v", x.Currency(), to, rate)
+	}
+	p := ConversionPreview{Send: x}
+	if p.Receive, err = fromRat(c, new(big.Rat).Mul(x.Rat(), rate), mode); err != nil {
+		return ConversionPreview{}, err
+	}
+	back := new(big.Rat).Quo(p.Receive.Rat(), rate)
+	if p.Back, err = fromRat(x.c, back, mode); err != nil {
+		return ConversionPreview{}, err
+	}
+	p.Lossy = back.Cmp(x.Rat()) != 0
+	return p, nil
+}
+
+// Fallback returns a RateProvider that tries each of providers in turn, returning the first rate
+// found, e.g. a live provider backed by a cached or embedded one.
+// If every provider fails, the error includes each provider's error.
+func Fallback(providers ...RateProvider) RateProvider {
+	return RateFunc(func(from, to string) (*big.Rat, error) {
+		errs := make([]error, 0, len(providers))
+		for _, p := range providers {
+			r, err := p.Rate(from, to)
+			if err == nil {
+				return r, nil
+			}
+			errs = append(errs, err)
+		}
+		return nil, fmt.Errorf("no rate from %s to %s: %w", from, to, errors.Join(errs...))
+	})
+}
@@ -0,0 +1,119 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// testRates is a RateProvider with a few fixed rates, and their inverses.
+var testRates = RateFunc(func(from, to string) (*big.Rat, error) {
+	rates := map[string]string{
+		"GBP/USD": "1.25",
+		"EUR/USD": "1.1",
+		"USD/JPY": "150",
+	}
+	if r, ok := rates[from+"/"+to]; ok {
+		v, _ := new(big.Rat).SetString(r)
+		return v, nil
+	}
+	if r, ok := rates[to+"/"+from]; ok {
+		v, _ := new(big.Rat).SetString(r)
+		return v.Inv(v), nil
+	}
+	return nil, fmt.Errorf("no rate from %s to %s", from, to)
+})
+
+func TestCanConvert(t *testing.T) {
+	var cases = []struct {
+		m    Money
+		to   string
+		mode RoundingMode
+		want string
+	}{
+		{MustNew("GBP", "100.00"), "USD", HalfUp, "USD 125.00"},
+		{MustNew("USD", "100.00"), "GBP", HalfUp, "GBP 80.00"},
+		{MustNew("USD", "1.00"), "EUR", HalfUp, "EUR 0.91"},
+		{MustNew("USD", "1.00"), "EUR", Down, "EUR 0.90"},
+		{MustNew("USD", "0.01"), "JPY", HalfUp, "JPY 2"},
+		{MustNew("GBP", "1.23"), "GBP", HalfUp, "GBP 1.23"},
+	}
+	for _, c := range cases {
+		got, err := Convert(c.m, c.to, testRates, c.mode)
+		if err != nil {
+			t.Errorf("error received from Convert(%v, %s), none expected %v", c.m, c.to, err)
+			continue
+		}
+		if got.String() != c.want {
+			t.Errorf("Convert(%v, %s, %v): wanted %s, got %v", c.m, c.to, c.mode, c.want, got)
+		}
+	}
+}
+
+func TestCanRejectBadConvert(t *testing.T) {
+	zero := RateFunc(func(from, to string) (*big.Rat, error) { return new(big.Rat), nil })
+	var cases = []struct {
+		to    string
+		rates RateProvider
+	}{
+		{"FOO", testRates},
+		{"CHF", testRates},
+		{"USD", zero},
+	}
+	for _, c := range cases {
+		if got, err := Convert(MustNew("GBP", "1.00"), c.to, c.rates, HalfUp); err == nil {
+			t.Errorf("error expected from Convert(GBP 1.00, %s), none received, got %v", c.to, got)
+		}
+	}
+}
+
+func TestCanPreviewConversion(t *testing.T) {
+	var cases = []struct {
+		x     Money
+		to    string
+		rate  string
+		mode  RoundingMode
+		recv  string
+		back  string
+		lossy bool
+	}{
+		{MustNew("GBP", "100.00"), "USD", "1.25", HalfUp, "USD 125.00", "GBP 100.00", false},
+		{MustNew("GBP", "0.01"), "USD", "1.25", HalfUp, "USD 0.01", "GBP 0.01", true},
+		{MustNew("GBP", "0.03"), "USD", "1.25", HalfUp, "USD 0.04", "GBP 0.03", true},
+		{MustNew("USD", "1.00"), "JPY", "150.5", HalfUp, "JPY 151", "USD 1.00", true},
+		{MustNew("USD", "10.00"), "JPY", "150.5", Down, "JPY 1505", "USD 10.00", false},
+		{MustNew("JPY", "1"), "USD", "1/150", HalfUp, "USD 0.01", "JPY 2", true},
+	}
+	for _, c := range cases {
+		r, _ := new(big.Rat).SetString(c.rate)
+		got, err := PreviewConversion(c.x, c.to, r, c.mode)
+		if err != nil || got.Send != c.x || got.Receive.String() != c.recv || got.Back.String() != c.back || got.Lossy != c.lossy {
+			t.Errorf("PreviewConversion(%v, %s, %s): wanted %s, back %s, lossy %t, got %+v (%v)", c.x, c.to, c.rate, c.recv, c.back, c.lossy, got, err)
+		}
+	}
+	if _, err := PreviewConversion(MustNew("GBP", "1.00"), "FOO", big.NewRat(1, 1), HalfUp); err == nil {
+		t.Errorf("error expected from PreviewConversion with bad currency, none received")
+	}
+	if _, err := PreviewConversion(MustNew("GBP", "1.00"), "USD", new(big.Rat), HalfUp); err == nil {
+		t.Errorf("error expected from PreviewConversion with zero rate, none received")
+	}
+}
+
+func TestCanFallBack(t *testing.T) {
+	down := RateFunc(func(from, to string) (*big.Rat, error) {
+		return nil, fmt.Errorf("rate service unavailable")
+	})
+	if got, err := Fallback(down, testRates).Rate("GBP", "USD"); err != nil || got.RatString() != "5/4" {
+		t.Errorf("wanted 5/4 from fallback, got %v (%v)", got, err)
+	}
+	if got, err := Fallback(testRates, down).Rate("GBP", "USD"); err != nil || got.RatString() != "5/4" {
+		t.Errorf("wanted 5/4 from first provider, got %v (%v)", got, err)
+	}
+	if _, err := Fallback(down, testRates).Rate("GBP", "CHF"); err == nil || !strings.Contains(err.Error(), "unavailable") {
+		t.Errorf("wanted error including each provider's error, got %v", err)
+	}
+	if _, err := Fallback().Rate("GBP", "USD"); err == nil {
+		t.Errorf("error expected from Fallback with no providers, none received")
+	}
+}
@@ -0,0 +1,82 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	"golang.org/x/text/currency"
+)
+
+type ratePair struct {
+	from, to currency.Unit
+}
+
+// RateSnapshot is an immutable set of rates, as returned by RateTable.Snapshot.
+// A RateSnapshot is a RateProvider. The zero value has no rates.
+type RateSnapshot struct {
+	m map[ratePair]*big.Rat
+}
+
+// Rate implements RateProvider. If there is no rate from one currency to the other, but there is
+// one the other way, its exact inverse is returned.
+func (s RateSnapshot) Rate(from, to string) (*big.Rat, error) {
+	f, err := currency.ParseISO(from)
+	if err != nil {
+		return nil, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	t, err := currency.ParseISO(to)
+	if err != nil {
+		return nil, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	if r, ok := s.m[ratePair{f, t}]; ok {
+		return new(big.Rat).Set(r), nil
+	}
+	if r, ok := s.m[ratePair{t, f}]; ok {
+		return new(big.Rat).Inv(r), nil
+	}
+	return nil, fmt.Errorf("no rate from %s to %s", from, to)
+}
+
+// Len returns the number of rates in the snapshot.
+func (s RateSnapshot) Len() int {
+	return len(s.m)
+}
+
+// RateTable is an in-memory set of rates that can be replaced as a whole while in use,
+// e.g. by a daily refresh. Each lookup sees either the old rates or the new ones, never a mix.
+// For several lookups that must be consistent with each other, use a Snapshot.
+// A RateTable is safe for concurrent use, and is a RateProvider.
+type RateTable struct {
+	p atomic.Pointer[RateSnapshot]
+}
+
+// NewRateTable returns a RateTable holding rates.
+func NewRateTable(rates ...Rate) *RateTable {
+	t := &RateTable{}
+	t.Replace(rates)
+	return t
+}
+
+// Replace atomically replaces all of the rates in t with rates.
+// If rates has more than one rate for a pair of currencies, the last is used.
+func (t *RateTable) Replace(rates []Rate) {
+	m := make(map[ratePair]*big.Rat, len(rates))
+	for _, r := range rates {
+		m[ratePair{r.from, r.to}] = r.r
+	}
+	t.p.Store(&RateSnapshot{m})
+}
+
+// Snapshot returns the current rates. It is unaffected by later calls to Replace.
+func (t *RateTable) Snapshot() RateSnapshot {
+	if s := t.p.Load(); s != nil {
+		return *s
+	}
+	return RateSnapshot{}
+}
+
+// Rate implements RateProvider, using the current rates as described at RateSnapshot.Rate.
+func (t *RateTable) Rate(from, to string) (*big.Rat, error) {
+	return t.Snapshot().Rate(from, to)
+}
@@ -0,0 +1,87 @@
+package dough
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCanLookUpRateTable(t *testing.T) {
+	gbpusd, _ := ParseRate("GBP", "USD", "1.25")
+	usdjpy, _ := ParseRate("USD", "JPY", "150")
+	table := NewRateTable(gbpusd, usdjpy)
+	var cases = []struct {
+		from, to string
+		want     string
+	}{
+		{"GBP", "USD", "5/4"},
+		{"USD", "GBP", "4/5"},
+		{"USD", "JPY", "150"},
+		{"JPY", "USD", "1/150"},
+	}
+	for _, c := range cases {
+		if got, err := table.Rate(c.from, c.to); err != nil || got.RatString() != c.want {
+			t.Errorf("Rate(%s, %s): wanted %s, got %v (%v)", c.from, c.to, c.want, got, err)
+		}
+	}
+	for _, pair := range [][2]string{{"GBP", "JPY"}, {"FOO", "USD"}, {"USD", "FOO"}} {
+		if got, err := table.Rate(pair[0], pair[1]); err == nil {
+			t.Errorf("error expected from Rate(%s, %s), none received, got %v", pair[0], pair[1], got)
+		}
+	}
+	// Rates returned can't modify the table.
+	r, _ := table.Rate("GBP", "USD")
+	r.SetInt64(2)
+	if got, _ := table.Rate("GBP", "USD"); got.RatString() != "5/4" {
+		t.Errorf("wanted 5/4 after modifying returned rate, got %s", got.RatString())
+	}
+	if _, err := (&RateTable{}).Rate("GBP", "USD"); err == nil {
+		t.Errorf("error expected from empty RateTable, none received")
+	}
+}
+
+func TestCanReplaceRateTable(t *testing.T) {
+	old, _ := ParseRate("GBP", "USD", "1.25")
+	table := NewRateTable(old)
+	snap := table.Snapshot()
+	eurusd, _ := ParseRate("EUR", "USD", "1.1")
+	gbpusd, _ := ParseRate("GBP", "USD", "1.3")
+	table.Replace([]Rate{gbpusd, eurusd})
+	if got, _ := snap.Rate("GBP", "USD"); got.RatString() != "5/4" || snap.Len() != 1 {
+		t.Errorf("snapshot: wanted only 5/4, got %v of %d", got, snap.Len())
+	}
+	if got, _ := table.Rate("GBP", "USD"); got.RatString() != "13/10" || table.Snapshot().Len() != 2 {
+		t.Errorf("table: wanted 13/10 of 2, got %v of %d", got, table.Snapshot().Len())
+	}
+}
+
+func TestCanReplaceRateTableConcurrently(t *testing.T) {
+	// In every table GBP/USD equals GBP/EUR, so a snapshot mixing two tables would be caught.
+	tables := make([][]Rate, 2)
+	for i, s := range []string{"1.25", "1.5"} {
+		a, _ := ParseRate("GBP", "USD", s)
+		b, _ := ParseRate("GBP", "EUR", s)
+		tables[i] = []Rate{a, b}
+	}
+	table := NewRateTable(tables[0]...)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			table.Replace(tables[i%2])
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			s := table.Snapshot()
+			a, _ := s.Rate("GBP", "USD")
+			b, _ := s.Rate("GBP", "EUR")
+			if a.Cmp(b) != 0 {
+				t.Errorf("inconsistent snapshot: %s and %s", a.RatString(), b.RatString())
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,54 @@
+package dough
+
+import "fmt"
+
+// RefundAllocate distributes a partial refund across the lines of an original payment,
+// in proportion to each line's amount. No line is refunded more than its original amount,
+// and the portions always sum to refund. Spare pennies are given to lines from first to last.
+// It returns an error if the amounts aren't all in the same currency, if any line or the
+// refund is negative, or if the refund is greater than the total of the original lines,
+// or one wrapping ErrOutOfRange if that total would overflow.
+func RefundAllocate(original []Money, refund Money) ([]Money, error) {
+	return allocateByAmount("refund", refund, original, nil)
+}
+
+// allocateByAmount distributes x across lines in proportion to their amounts, as RefundAllocate
+// does, except that lines with excluded[i] set receive none of it; excluded may be nil.
+// what names x in errors, and is used as both a noun and a verb, e.g. "refund" or "discount".
+func allocateByAmount(what string, x Money, lines []Money, excluded []bool) ([]Money, error) {
+	if x.a < 0 {
+		return nil, fmt.Errorf("can't allocate negative %s %v", what, x)
+	}
+	weights := make([]int64, len(lines))
+	total := 0
+	for i, y := range lines {
+		if y.Currency() != x.Currency() {
+			return nil, fmt.Errorf("Can't %s different currencies. Line %d is %s, %s is %s", what, i, y.Currency(), what, x.Currency())
+		}
+		if y.a < 0 {
+			return nil, fmt.Errorf("can't %s negative line %d (%v)", what, i, y)
+		}
+		if excluded != nil && excluded[i] {
+			continue
+		}
+		weights[i] = int64(y.a)
+		var ok bool
+		if total, ok = addAtoms(total, y.a); !ok {
+			return nil, fmt.Errorf("%w: total of lines to %s", ErrOutOfRange, what)
+		}
+	}
+	if x.a > total {
+		return nil, fmt.Errorf("%s of %v exceeds total of lines to %s, %s", what, x, what, Money{x.c, total}.Amount())
+	}
+	res := make([]Money, len(lines))
+	if x.a == 0 {
+		for i := range res {
+			res[i] = Money{x.c, 0}
+		}
+		return res, nil
+	}
+	for i, a := range allocate(x.a, weights) {
+		res[i] = Money{x.c, a}
+	}
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanAllocateRefund(t *testing.T) {
+	var cases = []struct {
+		lines  []string
+		refund string
+		want   []string
+	}{
+		{[]string{"10.00", "20.00", "30.00"}, "6.00", []string{"1.00", "2.00", "3.00"}},
+		{[]string{"10.00", "10.00", "10.00"}, "10.00", []string{"3.34", "3.33", "3.33"}},
+		{[]string{"0.01", "0.01", "0.01"}, "0.02", []string{"0.01", "0.01", "0.00"}},
+		{[]string{"0.00", "5.00", "0.00"}, "0.03", []string{"0.00", "0.03", "0.00"}},
+		{[]string{"1.00", "99.00"}, "100.00", []string{"1.00", "99.00"}},
+		{[]string{"0.01", "99.99"}, "99.99", []string{"0.01", "99.98"}},
+		{[]string{"3.33", "3.33", "3.34"}, "0.00", []string{"0.00", "0.00", "0.00"}},
+		{[]string{"1.05"}, "0.50", []string{"0.50"}},
+	}
+	for _, c := range cases {
+		lines := make([]Money, len(c.lines))
+		for i := range c.lines {
+			lines[i], _ = New("GBP", c.lines[i])
+		}
+		refund, _ := New("GBP", c.refund)
+		got, err := RefundAllocate(lines, refund)
+		if err != nil {
+			t.Errorf("error received from RefundAllocate(%v, %s), none expected %v", c.lines, c.refund, err)
+			continue
+		}
+		for i := range c.want {
+			if got[i].Amount() != c.want[i] {
+				t.Errorf("RefundAllocate(%v, %s), line %d: wanted %s, got %s", c.lines, c.refund, i, c.want[i], got[i].Amount())
+			}
+			if cmp, _ := got[i].Cmp(lines[i]); cmp > 0 {
+				t.Errorf("RefundAllocate(%v, %s), line %d over-refunded: %s", c.lines, c.refund, i, got[i].Amount())
+			}
+		}
+	}
+}
+
+func TestCanRejectBadRefund(t *testing.T) {
+	gbp, _ := New("GBP", "10.00")
+	eur, _ := New("EUR", "1.00")
+	neg, _ := New("GBP", "-1.00")
+	big, _ := New("GBP", "10.01")
+	var cases = []struct {
+		lines  []Money
+		refund Money
+	}{
+		{[]Money{gbp}, eur},
+		{[]Money{gbp, eur}, gbp},
+		{[]Money{gbp}, neg},
+		{[]Money{gbp, neg}, neg},
+		{[]Money{gbp}, big},
+		{nil, gbp},
+	}
+	for _, c := range cases {
+		if _, err := RefundAllocate(c.lines, c.refund); err == nil {
+			t.Errorf("error expected from RefundAllocate(%v, %v), none received", c.lines, c.refund)
+		}
+	}
+}
+
+func TestCanRejectRefundOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	if _, err := RefundAllocate([]Money{hi, hi}, gbp("1.00")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("RefundAllocate with lines totalling 2 × %v: wanted ErrOutOfRange, got %v", hi, err)
+	}
+}
@@ -0,0 +1,96 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BatchConverter converts a batch of amounts at a single rate, tracking the residue: the part of
+// each conversion lost or gained by rounding. Converting the last amount with Last applies the
+// residue to it, so that the converted amounts add up to exactly what converting the batch's total
+// would give, e.g. so that a settlement of many small card payments reconciles with the bank's
+// conversion of the total.
+// A BatchConverter is not safe for concurrent use.
+type BatchConverter struct {
+	rate Rate
+	mode RoundingMode
+	// exact is the exact total of the conversions so far, and rounded the total of their results.
+	exact   *big.Rat
+	rounded int
+}
+
+// NewBatchConverter returns a BatchConverter that converts at rate, rounding using mode.
+func NewBatchConverter(rate Rate, mode RoundingMode) *BatchConverter {
+	return &BatchConverter{rate: rate, mode: mode, exact: new(big.Rat)}
+}
+
+// Convert converts x, rounding as Rate.Apply does, and adds the rounding to the residue.
+// It returns an error if x isn't in the rate's base currency, or if the result can't be represented.
+func (b *BatchConverter) Convert(x Money) (Money, error) {
+	y, err := b.rate.Apply(x, b.mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return y, b.add(x, y)
+}
+
+// Last converts x, the last amount of the batch, applying the residue to it, so that the total of
+// all the conversions is the batch's total converted and rounded once. The result may therefore
+// differ from converting x alone by more than a minor unit. The residue is then zero, so the
+// BatchConverter can be used for another batch.
+// It returns an error if x isn't in the rate's base currency, or if the result can't be represented.
+func (b *BatchConverter) Last(x Money) (Money, error) {
+	if x.c != b.rate.from {
+		return Money{}, fmt.Errorf("Can't apply %s/%s rate to %s", b.rate.From(), b.rate.To(), x.Currency())
+	}
+	exact := new(big.Rat).Add(b.exact, new(big.Rat).Mul(x.Rat(), b.rate.r))
+	total, err := fromRat(b.rate.to, exact, b.mode)
+	if err != nil {
+		return Money{}, err
+	}
+	a, ok := addAtoms(total.a, -b.rounded)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: last conversion of %v", ErrOutOfRange, x)
+	}
+	b.exact.SetInt64(0)
+	b.rounded = 0
+	return Money{b.rate.to, a}, nil
+}
+
+// add records the conversion of x to y.
+func (b *BatchConverter) add(x, y Money) error {
+	z, ok := addAtoms(b.rounded, y.a)
+	if !ok {
+		return fmt.Errorf("%w: total of converted batch", ErrOutOfRange)
+	}
+	b.rounded = z
+	b.exact.Add(b.exact, new(big.Rat).Mul(x.Rat(), b.rate.r))
+	return nil
+}
+
+// Residue returns the residue so far: the exact total of the conversions less the total of their
+// rounded results, in major units of the rate's quote currency.
+func (b *BatchConverter) Residue() *big.Rat {
+	r := new(big.Rat).SetFrac(big.NewInt(int64(b.rounded)), new(big.Int).SetUint64(pow10[exponent(b.rate.to)]))
+	return r.Sub(b.exact, r)
+}
+
+// ConvertBatch converts each of xs at rate, rounding using mode, and applies the residue to the last,
+// as described at BatchConverter, so that the results add up to the total of xs converted directly.
+// It returns an error if any of xs isn't in the rate's base currency, or if a result can't be represented.
+func ConvertBatch(xs []Money, rate Rate, mode RoundingMode) ([]Money, error) {
+	b := NewBatchConverter(rate, mode)
+	res := make([]Money, len(xs))
+	for i, x := range xs {
+		var err error
+		if i == len(xs)-1 {
+			res[i], err = b.Last(x)
+		} else {
+			res[i], err = b.Convert(x)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package dough
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanConvertBatch(t *testing.T) {
+	rate, _ := ParseRate("GBP", "USD", "1.255")
+	var cases = []struct {
+		xs   []Money
+		mode RoundingMode
+		want []string
+	}{
+		{gbps("0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01"), HalfUp,
+			[]string{"0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.01", "0.04"}},
+		{gbps("10.00", "20.00"), HalfUp, []string{"12.55", "25.10"}},
+		{gbps("0.02", "0.02", "0.02"), HalfUp, []string{"0.03", "0.03", "0.02"}},
+		{gbps("0.02", "0.02", "0.02"), Down, []string{"0.02", "0.02", "0.03"}},
+		{gbps("0.01", "-0.01"), HalfUp, []string{"0.01", "-0.01"}},
+		{gbps("1.00"), HalfUp, []string{"1.26"}},
+		{nil, HalfUp, []string{}},
+	}
+	for _, c := range cases {
+		got, err := ConvertBatch(c.xs, rate, c.mode)
+		if err != nil {
+			t.Errorf("error received from ConvertBatch(%v), none expected %v", c.xs, err)
+			continue
+		}
+		amts := make([]string, len(got))
+		sum := MustNew("USD", "0.00")
+		for i, y := range got {
+			amts[i] = y.Amount()
+			sum, _ = sum.Add(y)
+		}
+		if !reflect.DeepEqual(amts, c.want) {
+			t.Errorf("ConvertBatch(%v, %v): wanted %v, got %v", c.xs, c.mode, c.want, amts)
+		}
+		total := gbp("0.00")
+		for _, x := range c.xs {
+			total, _ = total.Add(x)
+		}
+		if direct, _ := rate.Apply(total, c.mode); sum != direct {
+			t.Errorf("ConvertBatch(%v, %v): total %v, wanted %v as converted directly", c.xs, c.mode, sum, direct)
+		}
+	}
+}
+
+func TestCanTrackResidue(t *testing.T) {
+	rate, _ := ParseRate("GBP", "USD", "1.255")
+	b := NewBatchConverter(rate, HalfUp)
+	for i := 0; i < 9; i++ {
+		if got, err := b.Convert(gbp("0.01")); err != nil || got.Amount() != "0.01" {
+			t.Errorf("Convert: wanted USD 0.01, got %v (%v)", got, err)
+		}
+	}
+	if got := b.Residue().RatString(); got != "459/20000" {
+		t.Errorf("Residue: wanted 459/20000, got %s", got)
+	}
+	if got, err := b.Last(gbp("0.01")); err != nil || got.Amount() != "0.04" {
+		t.Errorf("Last: wanted USD 0.04, got %v (%v)", got, err)
+	}
+	if got := b.Residue().Sign(); got != 0 {
+		t.Errorf("wanted zero residue after Last, got %s", b.Residue().RatString())
+	}
+	if _, err := b.Convert(MustNew("EUR", "1.00")); err == nil {
+		t.Errorf("error expected from Convert with wrong currency, none received")
+	}
+	if _, err := b.Last(MustNew("EUR", "1.00")); err == nil {
+		t.Errorf("error expected from Last with wrong currency, none received")
+	}
+	if _, err := ConvertBatch([]Money{gbp("1.00"), MustNew("EUR", "1.00")}, rate, HalfUp); err == nil {
+		t.Errorf("error expected from ConvertBatch with wrong currency, none received")
+	}
+}
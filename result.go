@@ -0,0 +1,79 @@
+package dough
+
+// Result holds either a Money or an error, so that operations can be chained,
+// e.g. Ok(a).Add(b).Sub(c).Value(). Once a Result holds an error, further operations
+// do nothing and the first error is carried through to the end.
+// The zero value holds a zero Money with no currency.
+type Result struct {
+	x   Money
+	err error
+}
+
+// Ok returns a Result holding x.
+func Ok(x Money) Result {
+	return Result{x: x}
+}
+
+// ResultOf returns a Result holding x, or err if it isn't nil.
+// It can wrap any function returning (Money, error), e.g. ResultOf(New("GBP", "1.00")).
+func ResultOf(x Money, err error) Result {
+	if err != nil {
+		return Result{err: err}
+	}
+	return Result{x: x}
+}
+
+// Value returns the Money held by r, or its error.
+func (r Result) Value() (Money, error) {
+	if r.err != nil {
+		return Money{}, r.err
+	}
+	return r.x, nil
+}
+
+// Err returns the error held by r, or nil.
+func (r Result) Err() error {
+	return r.err
+}
+
+// Then returns the result of applying f to the Money held by r, or r if it holds an error.
+// It allows any operation to be chained, e.g. r.Then(func(x Money) (Money, error) { return Convert(x, "USD", rates, HalfUp) }).
+func (r Result) Then(f func(Money) (Money, error)) Result {
+	if r.err != nil {
+		return r
+	}
+	return ResultOf(f(r.x))
+}
+
+// Add returns a Result holding the Money held by r plus y.
+func (r Result) Add(y Money) Result {
+	return r.Then(func(x Money) (Money, error) { return x.Add(y) })
+}
+
+// Sub returns a Result holding the Money held by r minus y.
+func (r Result) Sub(y Money) Result {
+	return r.Then(func(x Money) (Money, error) { return x.Sub(y) })
+}
+
+// Mul returns a Result holding the Money held by r multiplied by factor.
+func (r Result) Mul(factor int) Result {
+	return r.Then(func(x Money) (Money, error) { return x.Mul(factor) })
+}
+
+// AddResult returns a Result holding the sum of the Money held by r and s,
+// or the error held by r or, failing that, s.
+func (r Result) AddResult(s Result) Result {
+	if s.err != nil && r.err == nil {
+		return s
+	}
+	return r.Add(s.x)
+}
+
+// SubResult returns a Result holding the Money held by r minus that held by s,
+// or the error held by r or, failing that, s.
+func (r Result) SubResult(s Result) Result {
+	if s.err != nil && r.err == nil {
+		return s
+	}
+	return r.Sub(s.x)
+}
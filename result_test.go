@@ -0,0 +1,51 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanChainResults(t *testing.T) {
+	got, err := Ok(gbp("10.00")).Add(gbp("5.00")).Sub(gbp("2.50")).Mul(2).Value()
+	if err != nil || got.Amount() != "25.00" {
+		t.Errorf("wanted 25.00, got %v (%v)", got, err)
+	}
+	got, err = ResultOf(New("GBP", "1.00")).AddResult(ResultOf(New("GBP", "0.50"))).SubResult(Ok(gbp("0.25"))).Value()
+	if err != nil || got.Amount() != "1.25" {
+		t.Errorf("wanted 1.25, got %v (%v)", got, err)
+	}
+	got, err = Ok(MustNew("GBP", "100.00")).Then(func(x Money) (Money, error) { return Convert(x, "USD", testRates, HalfUp) }).Value()
+	if err != nil || got.String() != "USD 125.00" {
+		t.Errorf("wanted USD 125.00, got %v (%v)", got, err)
+	}
+}
+
+func TestCanCarryFirstErrorInResult(t *testing.T) {
+	eur := MustNew("EUR", "1.00")
+	r := Ok(gbp("10.00")).Add(eur)
+	first := r.Err()
+	if first == nil {
+		t.Fatalf("error expected adding EUR to GBP, none received")
+	}
+	called := false
+	r = r.Sub(MustNew("USD", "1.00")).Mul(3).Then(func(x Money) (Money, error) {
+		called = true
+		return x, nil
+	})
+	if called {
+		t.Errorf("wanted Then not to be called after an error")
+	}
+	if got, err := r.Value(); err != first {
+		t.Errorf("wanted first error %v, got %v (%v)", first, err, got)
+	}
+	bad := errors.New("bad")
+	if err := ResultOf(Money{}, bad).Add(gbp("1.00")).Err(); err != bad {
+		t.Errorf("wanted error from ResultOf, got %v", err)
+	}
+	if err := Ok(gbp("1.00")).AddResult(ResultOf(Money{}, bad)).Err(); err != bad {
+		t.Errorf("wanted error from AddResult operand, got %v", err)
+	}
+	if err := ResultOf(Money{}, bad).SubResult(Ok(eur)).Err(); err != bad {
+		t.Errorf("wanted receiver's error from SubResult, got %v", err)
+	}
+}
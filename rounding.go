@@ -0,0 +1,155 @@
+package dough
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"golang.org/x/text/currency"
+)
+
+// RoundingMode determines how a value falling between two minor units is rounded.
+type RoundingMode int
+
+const (
+	// HalfUp rounds to the nearest minor unit, with halves rounded away from zero.
+	HalfUp RoundingMode = iota
+	// HalfDown rounds to the nearest minor unit, with halves rounded towards zero.
+	HalfDown
+	// HalfEven rounds to the nearest minor unit, with halves rounded to the even neighbour.
+	// This is also known as banker's rounding.
+	HalfEven
+	// Up rounds away from zero.
+	Up
+	// Down rounds towards zero, i.e. truncates.
+	Down
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+	// Floor rounds towards negative infinity.
+	Floor
+)
+
+// Default stands for the current default rounding mode, as set by SetDefaultRounding.
+// It is resolved when rounding happens, so it can be passed anywhere a RoundingMode is taken.
+const Default RoundingMode = -1
+
+var roundingModeNames = [...]string{
+	HalfUp:   "HalfUp",
+	HalfDown: "HalfDown",
+	HalfEven: "HalfEven",
+	Up:       "Up",
+	Down:     "Down",
+	Ceiling:  "Ceiling",
+	Floor:    "Floor",
+}
+
+func (m RoundingMode) String() string {
+	if m == Default {
+		return "Default"
+	}
+	if m < 0 || int(m) >= len(roundingModeNames) {
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+	return roundingModeNames[m]
+}
+
+// roundRat rounds r to an integer using the given mode.
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	if mode == Default {
+		mode = DefaultRounding()
+	}
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+	neg := r.Sign() < 0
+	away := false
+	switch mode {
+	case Up:
+		away = true
+	case Down:
+		away = false
+	case Ceiling:
+		away = !neg
+	case Floor:
+		away = neg
+	default:
+		// Compare twice the remainder with the denominator to find which side of half we're on.
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		switch twice.Cmp(r.Denom()) {
+		case 1:
+			away = true
+		case 0:
+			switch mode {
+			case HalfUp:
+				away = true
+			case HalfEven:
+				away = q.Bit(0) == 1
+			}
+		}
+	}
+	if away {
+		if neg {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// roundAtoms rounds r, a number of minor units, to an int using the given mode.
+// It returns an error if the result can't be represented.
+func roundAtoms(r *big.Rat, mode RoundingMode) (int, error) {
+	i := roundRat(r, mode)
+	if !i.IsInt64() || int64(int(i.Int64())) != i.Int64() || i.Int64() == math.MinInt64 {
+		return 0, fmt.Errorf("%w: %s", ErrOutOfRange, i)
+	}
+	return int(i.Int64()), nil
+}
+
+// Rat returns the exact amount of the Money in major units, e.g. 123.45 for £123.45.
+func (x Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(int64(x.a)), new(big.Int).SetUint64(pow10[x.Exponent()]))
+}
+
+// FromRat returns the Money in the given currency nearest to r major units, rounded using mode.
+// It returns an error if cur is not well formed or not recognised, or if the result can't be represented.
+func FromRat(cur string, r *big.Rat, mode RoundingMode) (Money, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return Money{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	return fromRat(c, r, mode)
+}
+
+// FromBigFloat returns the Money in the given currency nearest to f major units, rounded using mode.
+// rounded reports whether f had to be rounded, i.e. whether precision was lost.
+// It returns an error if cur is not well formed or not recognised, if f is infinite,
+// or if the result can't be represented.
+func FromBigFloat(cur string, f *big.Float, mode RoundingMode) (x Money, rounded bool, err error) {
+	if f.IsInf() {
+		return Money{}, false, fmt.Errorf("can't convert %v to %s", f, cur)
+	}
+	r, _ := f.Rat(nil)
+	x, err = FromRat(cur, r, mode)
+	if err != nil {
+		return Money{}, false, err
+	}
+	return x, x.Rat().Cmp(r) != 0, nil
+}
+
+// fromRat returns the Money in currency c nearest to r major units, rounded using mode.
+func fromRat(c currency.Unit, r *big.Rat, mode RoundingMode) (Money, error) {
+	u := new(big.Rat).SetInt(new(big.Int).SetUint64(pow10[exponent(c)]))
+	u.Mul(u, r)
+	a, err := roundAtoms(u, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{
+		c,
+		a,
+	}, nil
+}
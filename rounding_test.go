@@ -0,0 +1,108 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanRound(t *testing.T) {
+	var cases = []struct {
+		r    string
+		mode RoundingMode
+		want int
+	}{
+		{"5/2", HalfUp, 3},
+		{"-5/2", HalfUp, -3},
+		{"5/2", HalfDown, 2},
+		{"-5/2", HalfDown, -2},
+		{"5/2", HalfEven, 2},
+		{"7/2", HalfEven, 4},
+		{"-5/2", HalfEven, -2},
+		{"-7/2", HalfEven, -4},
+		{"21/10", HalfUp, 2},
+		{"29/10", HalfDown, 3},
+		{"21/10", Up, 3},
+		{"-21/10", Up, -3},
+		{"29/10", Down, 2},
+		{"-29/10", Down, -2},
+		{"21/10", Ceiling, 3},
+		{"-29/10", Ceiling, -2},
+		{"29/10", Floor, 2},
+		{"-21/10", Floor, -3},
+		{"4", Up, 4},
+		{"-4", Floor, -4},
+		{"0", HalfUp, 0},
+	}
+	for _, c := range cases {
+		r, _ := new(big.Rat).SetString(c.r)
+		if got, _ := roundAtoms(r, c.mode); got != c.want {
+			t.Errorf("rounding %s %v: wanted %d, got %d", c.r, c.mode, c.want, got)
+		}
+	}
+}
+
+func TestCanConvertToAndFromRat(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		r    string
+		mode RoundingMode
+		want string
+	}{
+		{"GBP", "123.45", HalfUp, "123.45"},
+		{"GBP", "123.455", HalfUp, "123.46"},
+		{"GBP", "123.455", HalfEven, "123.46"},
+		{"GBP", "123.445", HalfEven, "123.44"},
+		{"GBP", "-0.005", HalfUp, "-0.01"},
+		{"JPY", "1/3", Up, "1"},
+		{"KWD", "2/3", Down, "0.666"},
+	}
+	for _, c := range cases {
+		r, _ := new(big.Rat).SetString(c.r)
+		got, err := FromRat(c.cur, r, c.mode)
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("FromRat(%s, %s, %v): wanted %s, got %v (%v)", c.cur, c.r, c.mode, c.want, got, err)
+		}
+		want, _ := new(big.Rat).SetString(c.want)
+		if got.Rat().Cmp(want) != 0 {
+			t.Errorf("%v.Rat(): wanted %s, got %s", got, want.RatString(), got.Rat().RatString())
+		}
+	}
+	if _, err := FromRat("FOO", big.NewRat(1, 1), HalfUp); err == nil {
+		t.Errorf("error expected from FromRat with bad currency, none received")
+	}
+}
+
+func TestCanConvertFromBigFloat(t *testing.T) {
+	var cases = []struct {
+		cur     string
+		f       string
+		mode    RoundingMode
+		want    string
+		rounded bool
+	}{
+		{"GBP", "123.25", HalfUp, "123.25", false},
+		{"GBP", "123.5", HalfUp, "123.50", false},
+		{"GBP", "0.1", HalfUp, "0.10", true},
+		{"GBP", "123.125", HalfEven, "123.12", true},
+		{"GBP", "-123.125", HalfUp, "-123.13", true},
+		{"JPY", "1e3", Down, "1000", false},
+		{"JPY", "999.75", Down, "999", true},
+		{"GBP", "0", HalfUp, "0.00", false},
+	}
+	for _, c := range cases {
+		f, _, _ := big.ParseFloat(c.f, 10, 53, big.ToNearestEven)
+		got, rounded, err := FromBigFloat(c.cur, f, c.mode)
+		if err != nil || got.Amount() != c.want || rounded != c.rounded {
+			t.Errorf("FromBigFloat(%s, %s, %v): wanted %s (rounded %t), got %v (rounded %t, %v)", c.cur, c.f, c.mode, c.want, c.rounded, got, rounded, err)
+		}
+	}
+	if _, _, err := FromBigFloat("GBP", new(big.Float).SetInf(false), HalfUp); err == nil {
+		t.Errorf("error expected from FromBigFloat with infinity, none received")
+	}
+	if _, _, err := FromBigFloat("FOO", big.NewFloat(1), HalfUp); err == nil {
+		t.Errorf("error expected from FromBigFloat with bad currency, none received")
+	}
+	if _, _, err := FromBigFloat("GBP", big.NewFloat(1e30), HalfUp); err == nil {
+		t.Errorf("error expected from FromBigFloat with out of range amount, none received")
+	}
+}
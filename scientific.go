@@ -0,0 +1,96 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrScientificNotation is returned, wrapped, when an amount is given in scientific notation, e.g. "1.2e3",
+// which is only accepted with ParseOptions.AllowExponent.
+var ErrScientificNotation = errors.New("amount in scientific notation")
+
+// maxExponent limits the exponent of an amount in scientific notation, well beyond any representable amount,
+// so that expanding it can't use unbounded memory.
+const maxExponent = 40
+
+// splitExponent splits s, a decimal number in scientific notation without a sign, e.g. "1.2e3",
+// into its mantissa and exponent. ok is false if s isn't in scientific notation.
+func splitExponent(s string) (mantissa string, exp int, ok bool) {
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return "", 0, false
+	}
+	mantissa = s[:i]
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	if len(whole)+len(frac) == 0 || strings.Trim(whole+frac, "0123456789") != "" {
+		return "", 0, false
+	}
+	e := s[i+1:]
+	if strings.Trim(strings.TrimLeft(e, "+-"), "0123456789") != "" || len(e) > 0 && strings.IndexAny(e[1:], "+-") >= 0 {
+		return "", 0, false
+	}
+	// An exponent too large for an int is still scientific notation; it is clamped, and rejected by
+	// expandExponent as out of range.
+	exp, err := strconv.Atoi(e)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return "", 0, false
+	}
+	return mantissa, exp, true
+}
+
+// isScientific reports whether amt, with an optional minus sign, is in scientific notation.
+func isScientific(amt string) bool {
+	_, _, ok := splitExponent(strings.TrimPrefix(amt, "-"))
+	return ok
+}
+
+// badAmount returns the error for an amount that isn't in the form New accepts.
+func badAmount(amt string) error {
+	if isScientific(amt) {
+		return fmt.Errorf("%w: %s", ErrScientificNotation, amt)
+	}
+	return fmt.Errorf("unable to parse amount: %s", amt)
+}
+
+// expandExponent rewrites s, a decimal number in scientific notation without a sign, as a plain decimal
+// number with exactly places decimal places, or more if needed to be exact, e.g. "1200.00" for "1.2e3" and 2.
+// ok is false if s isn't in scientific notation. It returns an error wrapping ErrOutOfRange if s is non-zero
+// and its exponent is greater than maxExponent, or an error if it is less than -maxExponent.
+func expandExponent(s string, places int) (t string, ok bool, err error) {
+	mantissa, exp, ok := splitExponent(s)
+	if !ok {
+		return "", false, nil
+	}
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	digits := whole + frac
+	switch {
+	case strings.Trim(digits, "0") == "":
+		exp = 0
+	case exp > maxExponent:
+		return "", true, fmt.Errorf("%w: %s", ErrOutOfRange, s)
+	case exp < -maxExponent:
+		return "", true, fmt.Errorf("unable to parse amount: %s", s)
+	}
+	point := len(whole) + exp
+	switch {
+	case point <= 0:
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	whole = strings.TrimLeft(digits[:point], "0")
+	if whole == "" {
+		whole = "0"
+	}
+	frac = strings.TrimRight(digits[point:], "0")
+	if len(frac) < places {
+		frac += strings.Repeat("0", places-len(frac))
+	}
+	if frac == "" {
+		return whole, true, nil
+	}
+	return whole + "." + frac, true, nil
+}
@@ -0,0 +1,92 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanRejectScientificNotation(t *testing.T) {
+	var cases = []struct {
+		cur string
+		amt string
+	}{
+		{"GBP", "1.2e3"},
+		{"GBP", "-1.2E3"},
+		{"GBP", "5e-2"},
+		{"JPY", "1e+3"},
+		{"GBP", ".5e1"},
+	}
+	for _, c := range cases {
+		if _, err := New(c.cur, c.amt); !errors.Is(err, ErrScientificNotation) {
+			t.Errorf("New(%s, %s): wanted ErrScientificNotation, got %v", c.cur, c.amt, err)
+		}
+		if _, err := NewWithOptions(c.cur, c.amt, ParseOptions{AllowGrouping: true, AllowMissingMinor: true}); !errors.Is(err, ErrScientificNotation) {
+			t.Errorf("NewWithOptions(%s, %s) without AllowExponent: wanted ErrScientificNotation, got %v", c.cur, c.amt, err)
+		}
+	}
+	for _, amt := range []string{"e3", "1.2e", "1.2e3.4", "1.2e+-3", "1.2f3", "1,2e3"} {
+		if _, err := New("GBP", amt); err == nil || errors.Is(err, ErrScientificNotation) {
+			t.Errorf("New(GBP, %s): wanted an error other than ErrScientificNotation, got %v", amt, err)
+		}
+	}
+}
+
+func TestCanParseScientificNotation(t *testing.T) {
+	opts := ParseOptions{AllowExponent: true}
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "1.2e3", "1200.00"},
+		{"GBP", "-1.2E3", "-1200.00"},
+		{"GBP", "1.23e1", "12.30"},
+		{"GBP", "1.5e-1", "0.15"},
+		{"GBP", "5e-2", "0.05"},
+		{"GBP", "1.2300e1", "12.30"},
+		{"GBP", "0.001e3", "1.00"},
+		{"GBP", "12345e-2", "123.45"},
+		{"GBP", "0e0", "0.00"},
+		{"JPY", "1e+3", "1000"},
+		{"KWD", "1.2345e2", "123.450"},
+		{"GBP", "12.34", "12.34"},
+	}
+	for _, c := range cases {
+		got, err := NewWithOptions(c.cur, c.amt, opts)
+		if err != nil || got.Amount() != c.want {
+			t.Errorf("NewWithOptions(%s, %s, %+v): wanted %s, got %v (%v)", c.cur, c.amt, opts, c.want, got, err)
+		}
+	}
+	var bad = []struct {
+		cur string
+		amt string
+	}{
+		{"GBP", "1.2345e1"},
+		{"GBP", "1e-3"},
+		{"JPY", "1.5e0"},
+		{"GBP", "1e30"},
+		{"GBP", "1e999999999999999999999"},
+		{"GBP", "1e"},
+		{"GBP", "1,000e1"},
+	}
+	for _, c := range bad {
+		if got, err := NewWithOptions(c.cur, c.amt, opts); err == nil {
+			t.Errorf("error expected from NewWithOptions(%s, %s, %+v), none received, got %v", c.cur, c.amt, opts, got)
+		}
+	}
+	for _, amt := range []string{"1e30", "1e41", "-1e41", "1.5E+100", "1e999999999999999999999"} {
+		if _, err := NewWithOptions("GBP", amt, opts); !errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrScientificNotation) {
+			t.Errorf("NewWithOptions(GBP, %s): wanted ErrOutOfRange, got %v", amt, err)
+		}
+	}
+	for _, amt := range []string{"1e-41", "1e-999999999999999999999"} {
+		if _, err := NewWithOptions("GBP", amt, opts); err == nil || errors.Is(err, ErrScientificNotation) {
+			t.Errorf("NewWithOptions(GBP, %s): wanted an error other than ErrScientificNotation, got %v", amt, err)
+		}
+	}
+	for _, amt := range []string{"0e99", "0.0e-99", "0e999999999999999999999"} {
+		if got, err := NewWithOptions("GBP", amt, opts); err != nil || got.Amount() != "0.00" {
+			t.Errorf("NewWithOptions(GBP, %s): wanted 0.00, got %v (%v)", amt, got, err)
+		}
+	}
+}
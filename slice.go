@@ -0,0 +1,56 @@
+package dough
+
+import "fmt"
+
+// AddSlices returns the element-wise sum of a and b, e.g. prices and taxes for each order line.
+// It returns an error if a and b have different lengths, or if corresponding elements are in different currencies,
+// or one wrapping ErrOutOfRange if a sum would overflow.
+func AddSlices(a, b []Money) ([]Money, error) {
+	return addSubSlices(a, b, true)
+}
+
+// SubSlices returns the element-wise difference of a and b, e.g. prices less discounts for each order line.
+// It returns an error if a and b have different lengths, or if corresponding elements are in different currencies,
+// or one wrapping ErrOutOfRange if a difference would overflow.
+func SubSlices(a, b []Money) ([]Money, error) {
+	return addSubSlices(a, b, false)
+}
+
+func addSubSlices(a, b []Money, add bool) ([]Money, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("Can't combine slices of different lengths (%d and %d)", len(a), len(b))
+	}
+	res := make([]Money, len(a))
+	for i := range a {
+		m, err := addSub(a[i], b[i], add)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		res[i] = m
+	}
+	return res, nil
+}
+
+// ScaleSlice returns a new slice with each element of xs multiplied by factor.
+// It returns an error wrapping ErrOutOfRange if a product would overflow.
+func ScaleSlice(xs []Money, factor int) ([]Money, error) {
+	res := make([]Money, len(xs))
+	for i, x := range xs {
+		m, err := x.Mul(factor)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		res[i] = m
+	}
+	return res, nil
+}
+
+// NegateSlice returns a new slice with each element of xs negated, e.g. to turn charges into credits.
+// It can't overflow, as the range of Money is symmetric.
+func NegateSlice(xs []Money) []Money {
+	res := make([]Money, len(xs))
+	for i, x := range xs {
+		res[i] = Money{x.c, -x.a}
+	}
+	return res
+}
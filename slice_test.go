@@ -0,0 +1,66 @@
+package dough
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func gbps(amts ...string) []Money {
+	res := make([]Money, len(amts))
+	for i, a := range amts {
+		res[i] = gbp(a)
+	}
+	return res
+}
+
+func TestCanCombineSlices(t *testing.T) {
+	a := gbps("10.00", "5.50", "0.00")
+	b := gbps("2.00", "-0.50", "0.01")
+	if got, err := AddSlices(a, b); err != nil || !reflect.DeepEqual(got, gbps("12.00", "5.00", "0.01")) {
+		t.Errorf("AddSlices: wanted [12.00 5.00 0.01], got %v (%v)", got, err)
+	}
+	if got, err := SubSlices(a, b); err != nil || !reflect.DeepEqual(got, gbps("8.00", "6.00", "-0.01")) {
+		t.Errorf("SubSlices: wanted [8.00 6.00 -0.01], got %v (%v)", got, err)
+	}
+	if got, err := AddSlices(nil, nil); err != nil || len(got) != 0 {
+		t.Errorf("AddSlices(nil, nil): wanted empty slice, got %v (%v)", got, err)
+	}
+	eur, _ := New("EUR", "1.00")
+	if _, err := AddSlices(a, []Money{b[0], eur, b[2]}); err == nil {
+		t.Errorf("error expected from AddSlices with different currencies, none received")
+	}
+	if _, err := SubSlices(a, b[:2]); err == nil {
+		t.Errorf("error expected from SubSlices with different lengths, none received")
+	}
+}
+
+func TestCanScaleAndNegateSlices(t *testing.T) {
+	xs := gbps("1.99", "-0.50", "0.00")
+	if got, err := ScaleSlice(xs, 3); err != nil || !reflect.DeepEqual(got, gbps("5.97", "-1.50", "0.00")) {
+		t.Errorf("ScaleSlice: wanted [5.97 -1.50 0.00], got %v (%v)", got, err)
+	}
+	if got := NegateSlice(xs); !reflect.DeepEqual(got, gbps("-1.99", "0.50", "0.00")) {
+		t.Errorf("NegateSlice: wanted [-1.99 0.50 0.00], got %v", got)
+	}
+	if !reflect.DeepEqual(xs, gbps("1.99", "-0.50", "0.00")) {
+		t.Errorf("wanted input slice unchanged, got %v", xs)
+	}
+}
+
+func TestCanRejectSliceOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	lo, _ := MinValue("GBP")
+	if _, err := AddSlices([]Money{gbp("1.00"), hi}, gbps("1.00", "0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AddSlices overflowing at element 1: wanted ErrOutOfRange, got %v", err)
+	}
+	if _, err := SubSlices([]Money{lo}, gbps("0.01")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("SubSlices overflowing at element 0: wanted ErrOutOfRange, got %v", err)
+	}
+	if _, err := ScaleSlice([]Money{gbp("1.00"), hi}, 2); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ScaleSlice overflowing at element 1: wanted ErrOutOfRange, got %v", err)
+	}
+	if got := NegateSlice([]Money{hi, lo}); got[0] != lo || got[1] != hi {
+		t.Errorf("NegateSlice([%v %v]): wanted [%v %v], got %v", hi, lo, lo, hi, got)
+	}
+}
@@ -0,0 +1,44 @@
+package dough
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SumSeq returns the total of the amounts in seq, without collecting them first, so that very
+// long sequences, e.g. the rows of a file, can be totalled in constant memory.
+// It stops at the first error: if the sequence is empty, if an amount is in a different
+// currency from the first, or, wrapping ErrOutOfRange, if the running total would overflow.
+func SumSeq(seq iter.Seq[Money]) (Money, error) {
+	var total Money
+	var err error
+	n := 0
+	for x := range seq {
+		if n == 0 {
+			total = x
+		} else if total, err = addSub(total, x, true); err != nil {
+			return Money{}, fmt.Errorf("element %d: %w", n, err)
+		}
+		n++
+	}
+	if n == 0 {
+		return Money{}, fmt.Errorf("can't sum no amounts")
+	}
+	return total, nil
+}
+
+// SumChan returns the total of the amounts received from ch until it is closed, as described at
+// SumSeq. It reads from ch until it is closed even after an error, so that the sender is never
+// left blocked.
+func SumChan(ch <-chan Money) (Money, error) {
+	total, err := SumSeq(func(yield func(Money) bool) {
+		for x := range ch {
+			if !yield(x) {
+				return
+			}
+		}
+	})
+	for range ch {
+	}
+	return total, err
+}
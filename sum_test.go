@@ -0,0 +1,66 @@
+package dough
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCanSumSeq(t *testing.T) {
+	var cases = []struct {
+		xs   []Money
+		want string
+	}{
+		{gbps("1.00"), "GBP 1.00"},
+		{gbps("1.00", "2.50", "-0.25"), "GBP 3.25"},
+		{gbps("-1.00", "1.00"), "GBP 0.00"},
+	}
+	for _, c := range cases {
+		if got, err := SumSeq(slices.Values(c.xs)); err != nil || got.String() != c.want {
+			t.Errorf("SumSeq(%v): wanted %s, got %v (%v)", c.xs, c.want, got, err)
+		}
+		ch := make(chan Money)
+		go func() {
+			for _, x := range c.xs {
+				ch <- x
+			}
+			close(ch)
+		}()
+		if got, err := SumChan(ch); err != nil || got.String() != c.want {
+			t.Errorf("SumChan(%v): wanted %s, got %v (%v)", c.xs, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadSumSeq(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	var cases = []struct {
+		xs       []Money
+		overflow bool
+	}{
+		{nil, false},
+		{[]Money{MustNew("GBP", "1.00"), MustNew("EUR", "1.00")}, false},
+		{[]Money{hi, MustNew("GBP", "0.01")}, true},
+		{[]Money{hi, MustNew("GBP", "-0.01"), MustNew("GBP", "0.02")}, true},
+	}
+	for _, c := range cases {
+		got, err := SumSeq(slices.Values(c.xs))
+		if err == nil || errors.Is(err, ErrOutOfRange) != c.overflow {
+			t.Errorf("SumSeq(%v): wanted error (overflow %t), got %v (%v)", c.xs, c.overflow, got, err)
+		}
+		// The sender isn't left blocked after an error.
+		ch := make(chan Money)
+		done := make(chan struct{})
+		go func() {
+			for _, x := range c.xs {
+				ch <- x
+			}
+			close(ch)
+			close(done)
+		}()
+		if got, err := SumChan(ch); err == nil || errors.Is(err, ErrOutOfRange) != c.overflow {
+			t.Errorf("SumChan(%v): wanted error (overflow %t), got %v (%v)", c.xs, c.overflow, got, err)
+		}
+		<-done
+	}
+}
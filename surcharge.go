@@ -0,0 +1,66 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Surcharge is a charge for paying by a particular method, e.g. 1.5% + £0.20 for cards, capped at £5.
+type Surcharge struct {
+	// Rate is the percentage component.
+	Rate Proportion
+	// Fixed is the fixed component. The zero Money means none.
+	Fixed Money
+	// Cap is the largest surcharge. The zero Money means no cap.
+	Cap Money
+	// GrossUp charges Rate on the surcharged total, rather than on the amount before the surcharge,
+	// so that the merchant receives the whole amount after a fee of Rate + Fixed on what the customer pays.
+	GrossUp bool
+}
+
+// Apply returns the surcharge on x, rounded once to the currency's minor unit using mode, and the
+// new total. In gross-up mode the surcharge s solves s = Rate × (x + s) + Fixed exactly, i.e.
+// s = (Rate × x + Fixed) / (1 - Rate), before rounding and capping.
+// It returns an error if x is negative, Fixed or Cap is negative or in a different currency,
+// Rate is negative, Rate is 100% or more in gross-up mode, or the result can't be represented.
+func (s Surcharge) Apply(x Money, mode RoundingMode) (surcharge, total Money, err error) {
+	if x.a < 0 {
+		return Money{}, Money{}, fmt.Errorf("can't surcharge negative amount %v", x)
+	}
+	for _, y := range []Money{s.Fixed, s.Cap} {
+		if y.a == 0 {
+			continue
+		}
+		if y.c != x.c {
+			return Money{}, Money{}, fmt.Errorf("Can't apply %s surcharge to %s", y.Currency(), x.Currency())
+		}
+		if y.a < 0 {
+			return Money{}, Money{}, fmt.Errorf("surcharge components must not be negative, got %v", y)
+		}
+	}
+	rate := s.Rate.Rat()
+	if rate.Sign() < 0 {
+		return Money{}, Money{}, fmt.Errorf("surcharge rate must not be negative, got %v", s.Rate)
+	}
+	r := new(big.Rat).Mul(rate, big.NewRat(int64(x.a), 1))
+	r.Add(r, big.NewRat(int64(s.Fixed.a), 1))
+	if s.GrossUp {
+		keep := new(big.Rat).Sub(big.NewRat(1, 1), rate)
+		if keep.Sign() <= 0 {
+			return Money{}, Money{}, fmt.Errorf("can't gross up a surcharge rate of %v", s.Rate)
+		}
+		r.Quo(r, keep)
+	}
+	a, err := roundAtoms(r, mode)
+	if err != nil {
+		return Money{}, Money{}, err
+	}
+	if s.Cap.a != 0 && a > s.Cap.a {
+		a = s.Cap.a
+	}
+	surcharge = Money{x.c, a}
+	if total, err = x.Add(surcharge); err != nil {
+		return Money{}, Money{}, err
+	}
+	return surcharge, total, nil
+}
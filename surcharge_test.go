@@ -0,0 +1,62 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanApplySurcharge(t *testing.T) {
+	card := func(p float64) Proportion {
+		r, _ := NewPercent(p)
+		return r
+	}
+	var cases = []struct {
+		s         Surcharge
+		x         Money
+		mode      RoundingMode
+		surcharge Money
+		total     Money
+	}{
+		{Surcharge{Rate: card(1.5)}, gbp("100.00"), HalfUp, gbp("1.50"), gbp("101.50")},
+		{Surcharge{Rate: card(1.5), Fixed: gbp("0.20")}, gbp("100.00"), HalfUp, gbp("1.70"), gbp("101.70")},
+		{Surcharge{Rate: card(1.5), Fixed: gbp("0.20"), Cap: gbp("1.00")}, gbp("100.00"), HalfUp, gbp("1.00"), gbp("101.00")},
+		{Surcharge{Fixed: gbp("0.20")}, gbp("0.00"), HalfUp, gbp("0.20"), gbp("0.20")},
+		{Surcharge{Rate: card(2.9)}, gbp("10.10"), HalfUp, gbp("0.29"), gbp("10.39")},
+		{Surcharge{Rate: card(2.9)}, gbp("10.10"), Up, gbp("0.30"), gbp("10.40")},
+		// (2.9% × £100 + £0.30) / 97.1% is £3.2955..., and 2.9% of £103.30 + £0.30 is £3.2957.
+		{Surcharge{Rate: card(2.9), Fixed: gbp("0.30"), GrossUp: true}, gbp("100.00"), HalfUp, gbp("3.30"), gbp("103.30")},
+		{Surcharge{Rate: card(50), GrossUp: true}, gbp("10.00"), HalfUp, gbp("10.00"), gbp("20.00")},
+		{Surcharge{Rate: card(50), GrossUp: true, Cap: gbp("5.00")}, gbp("10.00"), HalfUp, gbp("5.00"), gbp("15.00")},
+		{Surcharge{Rate: NewProportion(big.NewRat(1, 3)), GrossUp: true}, gbp("1.00"), Down, gbp("0.50"), gbp("1.50")},
+		{Surcharge{}, gbp("1.00"), HalfUp, gbp("0.00"), gbp("1.00")},
+	}
+	for i, c := range cases {
+		s, total, err := c.s.Apply(c.x, c.mode)
+		if err != nil || s != c.surcharge || total != c.total {
+			t.Errorf("%d: surcharge on %v: wanted %v (total %v), got %v (total %v, %v)", i, c.x, c.surcharge, c.total, s, total, err)
+		}
+	}
+}
+
+func TestCanRejectBadSurcharge(t *testing.T) {
+	eur, _ := New("EUR", "0.20")
+	all, _ := NewPercent(100)
+	neg, _ := NewPercent(-1)
+	var cases = []struct {
+		s Surcharge
+		x Money
+	}{
+		{Surcharge{}, gbp("-1.00")},
+		{Surcharge{Fixed: eur}, gbp("1.00")},
+		{Surcharge{Cap: eur}, gbp("1.00")},
+		{Surcharge{Fixed: gbp("-0.20")}, gbp("1.00")},
+		{Surcharge{Cap: gbp("-0.20")}, gbp("1.00")},
+		{Surcharge{Rate: neg}, gbp("1.00")},
+		{Surcharge{Rate: all, GrossUp: true}, gbp("1.00")},
+	}
+	for i, c := range cases {
+		if s, _, err := c.s.Apply(c.x, HalfUp); err == nil {
+			t.Errorf("%d: error expected from Apply, none received, got %v", i, s)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package swift formats and parses Money as SWIFT MT amounts.
+//
+// MT amounts use a comma as the decimal separator, which is mandatory even
+// when there are no fraction digits, e.g. "1234,56" or "1234,". There is no
+// grouping or sign, and an amount is at most 15 characters, including the comma.
+// The number of fraction digits must not exceed the currency's minor unit.
+package swift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+// MaxLength is the maximum length of an MT amount, including the decimal comma.
+const MaxLength = 15
+
+// Format returns the amount of m as an MT amount, e.g. "1234,56", or "1234," for JPY.
+// It returns an error if m is negative, or too long.
+func Format(m dough.Money) (string, error) {
+	if m.MinorUnits() < 0 {
+		return "", fmt.Errorf("MT amounts can't be negative, got %v", m)
+	}
+	s := m.Amount()
+	if m.Exponent() == 0 {
+		s += ","
+	} else {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	if len(s) > MaxLength {
+		return "", fmt.Errorf("%v is longer than %d characters as an MT amount", m, MaxLength)
+	}
+	return s, nil
+}
+
+// Parse returns the Money for an MT amount in the given currency, e.g. Parse("EUR", "1234,5").
+// It returns an error if s isn't a valid MT amount, or has more fraction digits than the currency's minor unit.
+func Parse(ccy, s string) (dough.Money, error) {
+	if len(s) > MaxLength {
+		return dough.Money{}, fmt.Errorf("MT amount %q is longer than %d characters", s, MaxLength)
+	}
+	i := strings.IndexByte(s, ',')
+	if i < 1 || strings.IndexFunc(s, func(r rune) bool { return r != ',' && (r < '0' || r > '9') }) >= 0 || strings.Count(s, ",") != 1 {
+		return dough.Money{}, fmt.Errorf("invalid MT amount %q", s)
+	}
+	frac := len(s) - i - 1
+	units, err := strconv.ParseInt(s[:i]+s[i+1:], 10, 64)
+	if err != nil {
+		return dough.Money{}, fmt.Errorf("invalid MT amount %q: %v", s, err)
+	}
+	z, err := dough.FromMinorUnits(ccy, 0)
+	if err != nil {
+		return dough.Money{}, err
+	}
+	if frac > z.Exponent() {
+		return dough.Money{}, fmt.Errorf("MT amount %q has more fraction digits than %s allows (%d)", s, ccy, z.Exponent())
+	}
+	return dough.FromScaled(ccy, units, frac)
+}
+
+// FormatCurrencyAmount returns m as an MT currency and amount subfield, e.g. "EUR1234,56",
+// as used in fields such as 32B and 33B.
+func FormatCurrencyAmount(m dough.Money) (string, error) {
+	s, err := Format(m)
+	if err != nil {
+		return "", err
+	}
+	return m.Currency() + s, nil
+}
+
+// ParseCurrencyAmount returns the Money for an MT currency and amount subfield, e.g. "EUR1234,56".
+func ParseCurrencyAmount(s string) (dough.Money, error) {
+	if len(s) < 4 {
+		return dough.Money{}, fmt.Errorf("invalid MT currency and amount %q", s)
+	}
+	return Parse(s[:3], s[3:])
+}
@@ -0,0 +1,67 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/itsoneiota/dough-go"
+)
+
+func TestCanFormatMT(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"EUR", "1234.56", "1234,56"},
+		{"EUR", "0.00", "0,00"},
+		{"JPY", "1234", "1234,"},
+		{"KWD", "1.234", "1,234"},
+		{"EUR", "123456789012.34", "123456789012,34"},
+	}
+	for _, c := range cases {
+		m, _ := dough.New(c.cur, c.amt)
+		if got, err := Format(m); err != nil || got != c.want {
+			t.Errorf("Format(%v): wanted %s, got %s (%v)", m, c.want, got, err)
+		}
+		if got, err := FormatCurrencyAmount(m); err != nil || got != c.cur+c.want {
+			t.Errorf("FormatCurrencyAmount(%v): wanted %s%s, got %s (%v)", m, c.cur, c.want, got, err)
+		}
+	}
+	for _, s := range []string{"EUR -1.00", "EUR 1234567890123.45"} {
+		m, _ := dough.Parse(s)
+		if _, err := Format(m); err == nil {
+			t.Errorf("error expected from Format(%v), none received", m)
+		}
+	}
+}
+
+func TestCanParseMT(t *testing.T) {
+	var cases = []struct {
+		s    string
+		want string
+	}{
+		{"EUR1234,56", "EUR 1234.56"},
+		{"EUR1234,5", "EUR 1234.50"},
+		{"EUR1234,", "EUR 1234.00"},
+		{"JPY1234,", "JPY 1234"},
+		{"EUR0,01", "EUR 0.01"},
+		{"KWD1,2", "KWD 1.200"},
+	}
+	for _, c := range cases {
+		got, err := ParseCurrencyAmount(c.s)
+		if err != nil || got.String() != c.want {
+			t.Errorf("ParseCurrencyAmount(%s): wanted %s, got %v (%v)", c.s, c.want, got, err)
+		}
+	}
+}
+
+func TestCanRejectBadMT(t *testing.T) {
+	for _, s := range []string{
+		"", "EUR", "EUR1234", "EUR1234.56", "EUR,56", "EUR1,234,56", "EUR-1,00",
+		"EUR1 234,56", "EUR1234,567", "JPY1234,5", "EUR12345678901234,5", "FOO1,00",
+	} {
+		if _, err := ParseCurrencyAmount(s); err == nil {
+			t.Errorf("error expected from ParseCurrencyAmount(%q), none received", s)
+		}
+	}
+}
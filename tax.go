@@ -0,0 +1,82 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// TaxRounding determines at which point tax is rounded to the currency's minor unit.
+// Tax authorities mandate different strategies, and they can give totals a few minor units apart.
+type TaxRounding int
+
+const (
+	// PerLine rounds the tax on each line, and totals the rounded amounts.
+	PerLine TaxRounding = iota
+	// PerInvoice totals the exact tax on every line and rounds only the total.
+	// The total is then apportioned to the lines, each within one minor unit of its exact tax.
+	PerInvoice
+)
+
+// TaxLine is a line of an invoice, for the purposes of calculating tax.
+type TaxLine struct {
+	Net  Money
+	Rate Proportion
+}
+
+// TaxBreakdown is the tax on an invoice.
+type TaxBreakdown struct {
+	// Lines is the tax on each line, in the same order as the lines. They sum exactly to Total.
+	Lines []Money
+	// Total is the tax on the invoice.
+	Total Money
+	// Difference is the per-invoice total less the per-line total, whichever strategy was used.
+	Difference Money
+}
+
+// Tax calculates the tax on lines, rounding using mode at the point given by rounding.
+// It returns an error if there are no lines, the lines are in different currencies,
+// or the tax can't be represented.
+func Tax(lines []TaxLine, rounding TaxRounding, mode RoundingMode) (TaxBreakdown, error) {
+	if len(lines) == 0 {
+		return TaxBreakdown{}, fmt.Errorf("can't calculate tax on no lines")
+	}
+	c := lines[0].Net.c
+	perLine := make([]Money, len(lines))
+	perInvoice := make([]Money, len(lines))
+	var lineTotal int
+	exact, prev := new(big.Rat), 0
+	for i, l := range lines {
+		if l.Net.c != c {
+			return TaxBreakdown{}, fmt.Errorf("Can't calculate tax on different currencies (%s and %s)", lines[0].Net.Currency(), l.Net.Currency())
+		}
+		t := l.Rate.Rat()
+		t.Mul(t, big.NewRat(int64(l.Net.a), 1))
+		a, err := roundAtoms(t, mode)
+		if err != nil {
+			return TaxBreakdown{}, err
+		}
+		var ok bool
+		if lineTotal, ok = addAtoms(lineTotal, a); !ok {
+			return TaxBreakdown{}, fmt.Errorf("%w: total tax", ErrOutOfRange)
+		}
+		perLine[i] = Money{c, a}
+		// Round the running total, so that the lines sum to the rounded total of the invoice.
+		exact.Add(exact, t)
+		cum, err := roundAtoms(exact, mode)
+		if err != nil {
+			return TaxBreakdown{}, err
+		}
+		perInvoice[i] = Money{c, cum - prev}
+		prev = cum
+	}
+	b := TaxBreakdown{Lines: perLine, Total: Money{c, lineTotal}}
+	if rounding == PerInvoice {
+		b.Lines, b.Total = perInvoice, Money{c, prev}
+	}
+	d, ok := addAtoms(prev, -lineTotal)
+	if !ok {
+		return TaxBreakdown{}, fmt.Errorf("%w: tax difference", ErrOutOfRange)
+	}
+	b.Difference = Money{c, d}
+	return b, nil
+}
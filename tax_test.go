@@ -0,0 +1,65 @@
+package dough
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestCanCalculateTax(t *testing.T) {
+	vat, _ := NewPercent(20)
+	reduced, _ := NewPercent(5)
+	// 20% of £0.33 is 6.6p; three lines round up to 21p, but 19.8p in total rounds to 20p.
+	three := []TaxLine{{gbp("0.33"), vat}, {gbp("0.33"), vat}, {gbp("0.33"), vat}}
+	var cases = []struct {
+		lines    []TaxLine
+		rounding TaxRounding
+		mode     RoundingMode
+		want     []Money
+		total    Money
+		diff     Money
+	}{
+		{three, PerLine, HalfUp, gbps("0.07", "0.07", "0.07"), gbp("0.21"), gbp("-0.01")},
+		{three, PerInvoice, HalfUp, gbps("0.07", "0.06", "0.07"), gbp("0.20"), gbp("-0.01")},
+		{three, PerLine, Down, gbps("0.06", "0.06", "0.06"), gbp("0.18"), gbp("0.01")},
+		{three, PerInvoice, Down, gbps("0.06", "0.07", "0.06"), gbp("0.19"), gbp("0.01")},
+		{[]TaxLine{{gbp("10.00"), vat}, {gbp("4.50"), reduced}}, PerLine, HalfUp, gbps("2.00", "0.23"), gbp("2.23"), gbp("0.00")},
+		{[]TaxLine{{gbp("10.00"), vat}, {gbp("4.50"), reduced}}, PerInvoice, HalfUp, gbps("2.00", "0.23"), gbp("2.23"), gbp("0.00")},
+		{[]TaxLine{{gbp("0.33"), vat}, {gbp("-0.33"), vat}}, PerInvoice, HalfUp, gbps("0.07", "-0.07"), gbp("0.00"), gbp("0.00")},
+		{[]TaxLine{{gbp("1.00"), NewProportion(big.NewRat(1, 3))}}, PerInvoice, HalfEven, gbps("0.33"), gbp("0.33"), gbp("0.00")},
+	}
+	for i, c := range cases {
+		got, err := Tax(c.lines, c.rounding, c.mode)
+		if err != nil {
+			t.Errorf("%d: error received from Tax, none expected %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Lines, c.want) || got.Total != c.total || got.Difference != c.diff {
+			t.Errorf("%d: wanted lines %v, total %v, difference %v, got %v, %v, %v", i, c.want, c.total, c.diff, got.Lines, got.Total, got.Difference)
+		}
+		sum := gbp("0.00")
+		for _, l := range got.Lines {
+			sum, _ = sum.Add(l)
+		}
+		if sum != got.Total {
+			t.Errorf("%d: lines sum to %v, wanted %v", i, sum, got.Total)
+		}
+	}
+}
+
+func TestCanRejectBadTax(t *testing.T) {
+	vat, _ := NewPercent(20)
+	eur, _ := New("EUR", "1.00")
+	hi, _ := MaxValue("GBP")
+	var cases = [][]TaxLine{
+		nil,
+		{{gbp("1.00"), vat}, {eur, vat}},
+		{{hi, vat.Plus()}},
+		{{hi, vat.Plus().Plus()}, {hi, NewProportion(big.NewRat(1, 1))}},
+	}
+	for i, lines := range cases {
+		if got, err := Tax(lines, PerLine, HalfUp); err == nil {
+			t.Errorf("%d: error expected from Tax, none received, got %+v", i, got)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package dough
+
+import (
+	"math"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// compactSuffixes are the suffixes used by moneyCompact for successive powers of 1000. They are English;
+// golang.org/x/text has no CLDR compact decimal patterns to localise them with.
+var compactSuffixes = [...]string{"", "K", "M", "B", "T"}
+
+// TemplateFuncs returns functions for rendering Money in text/template and html/template
+// templates, with numbers formatted for the language tag. The result can be passed directly
+// to the Funcs method of either kind of template. The functions are:
+//
+//	money         the currency code and amount, e.g. "GBP 1,234.56"
+//	moneySymbol   the currency symbol and amount, e.g. "£ 1,234.56"
+//	moneyCompact  the currency symbol and amount abbreviated to thousands, millions, etc.,
+//	              e.g. "£1.2K" or "-£3.5M"
+//
+// moneyCompact is English-only: the number is formatted for the language tag, but the K, M, B and T
+// suffixes and their placement are not localised, e.g. German would want "1,2 Tsd. €".
+//
+// Amounts are formatted for display only, via float64, so very large amounts may lose precision.
+func TemplateFuncs(tag language.Tag) map[string]interface{} {
+	p := message.NewPrinter(tag)
+	return map[string]interface{}{
+		"money": func(x Money) string {
+			return p.Sprint(currency.ISO(x.c.Amount(x.float64())))
+		},
+		"moneySymbol": func(x Money) string {
+			return p.Sprint(currency.Symbol(x.c.Amount(x.float64())))
+		},
+		"moneyCompact": func(x Money) string {
+			return moneyCompact(p, x)
+		},
+	}
+}
+
+func (x Money) float64() float64 {
+	f, _ := x.Rat().Float64()
+	return f
+}
+
+func moneyCompact(p *message.Printer, x Money) string {
+	v := math.Abs(x.float64())
+	sign := ""
+	if x.a < 0 {
+		sign = "-"
+	}
+	sym := p.Sprint(currency.Symbol(x.c))
+	if v < 1000 {
+		return sign + sym + p.Sprint(number.Decimal(v, number.Scale(x.Exponent())))
+	}
+	k := 0
+	for k < len(compactSuffixes)-1 && math.Round(v*10)/10 >= 1000 {
+		v /= 1000
+		k++
+	}
+	v = math.Round(v*10) / 10
+	return sign + sym + p.Sprint(number.Decimal(v, number.MaxFractionDigits(1))) + compactSuffixes[k]
+}
@@ -0,0 +1,56 @@
+package dough
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"golang.org/x/text/language"
+)
+
+func TestCanUseTemplateFuncs(t *testing.T) {
+	var cases = []struct {
+		tag  language.Tag
+		fn   string
+		m    string
+		want string
+	}{
+		{language.BritishEnglish, "money", "GBP 1234.56", "GBP 1,234.56"},
+		{language.German, "money", "EUR 1234.56", "EUR 1.234,56"},
+		{language.BritishEnglish, "money", "JPY 1234567", "JPY 1,234,567"},
+		{language.BritishEnglish, "moneySymbol", "GBP -12.30", "£ -12.30"},
+		{language.AmericanEnglish, "moneySymbol", "USD 3.50", "$ 3.50"},
+		{language.French, "moneySymbol", "EUR 1234.50", "€ 1\u00a0234,50"},
+		{language.BritishEnglish, "moneyCompact", "GBP 999.99", "£999.99"},
+		{language.BritishEnglish, "moneyCompact", "GBP 1234.56", "£1.2K"},
+		{language.BritishEnglish, "moneyCompact", "GBP 1000.00", "£1K"},
+		{language.BritishEnglish, "moneyCompact", "GBP -3500000.00", "-£3.5M"},
+		{language.BritishEnglish, "moneyCompact", "GBP 999950.00", "£1M"},
+		{language.German, "moneyCompact", "EUR 2500000000.00", "€2,5B"},
+		{language.BritishEnglish, "moneyCompact", "GBP 5000000000000000.00", "£5,000T"},
+	}
+	for _, c := range cases {
+		m, _ := Parse(c.m)
+		tmpl := template.Must(template.New("").Funcs(TemplateFuncs(c.tag)).Parse("{{" + c.fn + " .}}"))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, m); err != nil {
+			t.Errorf("error received executing %s(%s), none expected %v", c.fn, c.m, err)
+			continue
+		}
+		if b.String() != c.want {
+			t.Errorf("%s %s(%s): wanted %q, got %q", c.tag, c.fn, c.m, c.want, b.String())
+		}
+	}
+}
+
+func TestCanUseTemplateFuncsInHTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(TemplateFuncs(language.BritishEnglish)).Parse("<td>{{moneySymbol .}}</td>"))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, gbp("1234.56")); err != nil {
+		t.Fatalf("error received executing template, none expected %v", err)
+	}
+	if want := "<td>£ 1,234.56</td>"; b.String() != want {
+		t.Errorf("wanted %q, got %q", want, b.String())
+	}
+}
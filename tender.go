@@ -0,0 +1,60 @@
+package dough
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Tender is a means of payment available at checkout, e.g. a gift card, store credit or a card.
+type Tender struct {
+	// Name identifies the tender, e.g. "gift card".
+	Name string
+	// Max is the most that can be captured from the tender, e.g. a gift card's balance.
+	// It is ignored if Unlimited is true.
+	Max Money
+	// Unlimited is true if the tender can cover any amount, e.g. a card.
+	Unlimited bool
+	// Priority determines the order tenders are used in: lower priorities are used first.
+	// Tenders with equal priority are used in the order given.
+	Priority int
+}
+
+// SplitTenders splits total across tenders, taking as much as possible from each tender in
+// priority order, up to its maximum, until total is covered. It returns the amount to capture
+// from each tender, in the same order as tenders. Tenders that aren't needed are given zero.
+// It returns an error if total or a tender's maximum is negative or in a different currency,
+// or if the tenders can't cover total between them.
+func SplitTenders(total Money, tenders []Tender) ([]Money, error) {
+	if total.a < 0 {
+		return nil, fmt.Errorf("can't split negative total %v", total)
+	}
+	order := make([]int, len(tenders))
+	for i, t := range tenders {
+		if !t.Unlimited {
+			if t.Max.Currency() != total.Currency() {
+				return nil, fmt.Errorf("Can't split %s total across %s tender %q", total.Currency(), t.Max.Currency(), t.Name)
+			}
+			if t.Max.a < 0 {
+				return nil, fmt.Errorf("tender %q has negative maximum %v", t.Name, t.Max)
+			}
+		}
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return tenders[order[i]].Priority < tenders[order[j]].Priority
+	})
+	res := make([]Money, len(tenders))
+	rem := total.a
+	for _, i := range order {
+		a := rem
+		if t := tenders[i]; !t.Unlimited && t.Max.a < a {
+			a = t.Max.a
+		}
+		res[i] = Money{total.c, a}
+		rem -= a
+	}
+	if rem > 0 {
+		return nil, fmt.Errorf("tenders can't cover total of %v, %s outstanding", total, Money{total.c, rem}.Amount())
+	}
+	return res, nil
+}
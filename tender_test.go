@@ -0,0 +1,53 @@
+package dough
+
+import "testing"
+
+func TestCanSplitTenders(t *testing.T) {
+	giftCard := Tender{Name: "gift card", Max: gbp("25.00")}
+	credit := Tender{Name: "store credit", Max: gbp("10.01"), Priority: 1}
+	card := Tender{Name: "card", Unlimited: true, Priority: 2}
+	var cases = []struct {
+		total   string
+		tenders []Tender
+		want    []string
+	}{
+		{"100.00", []Tender{giftCard, credit, card}, []string{"25.00", "10.01", "64.99"}},
+		{"100.00", []Tender{card, credit, giftCard}, []string{"64.99", "10.01", "25.00"}},
+		{"30.00", []Tender{giftCard, credit, card}, []string{"25.00", "5.00", "0.00"}},
+		{"20.00", []Tender{giftCard, credit, card}, []string{"20.00", "0.00", "0.00"}},
+		{"35.01", []Tender{giftCard, credit}, []string{"25.00", "10.01"}},
+		{"0.00", []Tender{giftCard, card}, []string{"0.00", "0.00"}},
+		{"10.00", []Tender{{Name: "a", Max: gbp("6.00")}, {Name: "b", Max: gbp("6.00")}}, []string{"6.00", "4.00"}},
+	}
+	for _, c := range cases {
+		got, err := SplitTenders(gbp(c.total), c.tenders)
+		if err != nil {
+			t.Errorf("error received from SplitTenders(%s), none expected %v", c.total, err)
+			continue
+		}
+		for i, m := range got {
+			if m.Amount() != c.want[i] {
+				t.Errorf("SplitTenders(%s), tender %q: wanted %s, got %s", c.total, c.tenders[i].Name, c.want[i], m.Amount())
+			}
+		}
+	}
+}
+
+func TestCanRejectBadTenders(t *testing.T) {
+	eur, _ := New("EUR", "10.00")
+	var cases = []struct {
+		total   string
+		tenders []Tender
+	}{
+		{"-1.00", []Tender{{Unlimited: true}}},
+		{"10.00", []Tender{{Max: eur}}},
+		{"10.00", []Tender{{Max: gbp("-1.00")}, {Unlimited: true}}},
+		{"10.00", []Tender{{Max: gbp("9.99")}}},
+		{"10.00", nil},
+	}
+	for _, c := range cases {
+		if got, err := SplitTenders(gbp(c.total), c.tenders); err == nil {
+			t.Errorf("error expected from SplitTenders(%s, %v), none received, got %v", c.total, c.tenders, got)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package dough
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse returns a new Money from its string form, a currency code and amount
+// separated by whitespace, e.g. "GBP 123.45", as returned by String.
+// It returns an error if s is not in that form, or if New would return an error.
+func Parse(s string) (Money, error) {
+	f := strings.Fields(s)
+	if len(f) != 2 {
+		return Money{}, fmt.Errorf("couldn't parse money: expected currency and amount, e.g. \"GBP 123.45\", got %q", s)
+	}
+	return New(f[0], f[1])
+}
+
+// MarshalText implements encoding.TextMarshaler, using the form returned by String.
+func (x Money) MarshalText() ([]byte, error) {
+	return x.AppendString(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the form accepted by Parse.
+func (x *Money) UnmarshalText(text []byte) error {
+	m, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*x = m
+	return nil
+}
+
+// Scan implements fmt.Scanner, accepting the form accepted by Parse with the verbs %v and %s,
+// so that e.g. fmt.Sscan("GBP 12.34", &m) works.
+func (x *Money) Scan(state fmt.ScanState, verb rune) error {
+	if verb != 'v' && verb != 's' {
+		return fmt.Errorf("can't scan money with verb %%%c", verb)
+	}
+	cur, err := state.Token(true, nil)
+	if err != nil {
+		return err
+	}
+	c := string(cur)
+	amt, err := state.Token(true, nil)
+	if err != nil {
+		return err
+	}
+	m, err := New(c, string(amt))
+	if err != nil {
+		return err
+	}
+	*x = m
+	return nil
+}
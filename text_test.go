@@ -0,0 +1,91 @@
+package dough
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestCanParse(t *testing.T) {
+	var cases = []struct {
+		s   string
+		cur string
+		amt string
+	}{
+		{"GBP 123.45", "GBP", "123.45"},
+		{"EUR -0.01", "EUR", "-0.01"},
+		{"  AUD\t1.00\n", "AUD", "1.00"},
+	}
+	for _, c := range cases {
+		sut, err := Parse(c.s)
+		if err != nil {
+			t.Errorf("error received from Parse(%q), none expected %v", c.s, err)
+		}
+		if sut.Currency() != c.cur || sut.Amount() != c.amt {
+			t.Errorf("wanted %s %s, got %v", c.cur, c.amt, sut)
+		}
+	}
+}
+
+func TestCanRejectBadParse(t *testing.T) {
+	var cases = []struct {
+		s string
+	}{
+		{""},
+		{"GBP"},
+		{"123.45"},
+		{"123.45 GBP"},
+		{"GBP 123.45 GBP"},
+		{"GBP123.45"},
+		{"FOO 1.00"},
+	}
+	for _, c := range cases {
+		if _, err := Parse(c.s); err == nil {
+			t.Errorf("error expected from Parse(%q), none received", c.s)
+		}
+	}
+}
+
+func TestCanRoundTripText(t *testing.T) {
+	x, _ := New("GBP", "-123.45")
+	b, err := json.Marshal(map[string]Money{"price": x})
+	if err != nil {
+		t.Fatalf("error received from json.Marshal, none expected %v", err)
+	}
+	if want := `{"price":"GBP -123.45"}`; string(b) != want {
+		t.Errorf("wanted %s, got %s", want, b)
+	}
+	var got map[string]Money
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("error received from json.Unmarshal, none expected %v", err)
+	}
+	if got["price"] != x {
+		t.Errorf("wanted %v, got %v", x, got["price"])
+	}
+	var m Money
+	if err := m.UnmarshalText([]byte("nonsense")); err == nil {
+		t.Errorf("error expected from UnmarshalText(\"nonsense\"), none received")
+	}
+}
+
+func TestCanScan(t *testing.T) {
+	var m, n Money
+	var i int
+	if _, err := fmt.Sscan("GBP 12.34 7 EUR -0.01", &m, &i, &n); err != nil {
+		t.Fatalf("error received from Sscan, none expected %v", err)
+	}
+	if m.String() != "GBP 12.34" || i != 7 || n.String() != "EUR -0.01" {
+		t.Errorf("Sscan: wanted GBP 12.34, 7, EUR -0.01, got %v, %d, %v", m, i, n)
+	}
+	if _, err := fmt.Sscanf("price: JPY 500", "price: %v", &m); err != nil || m.String() != "JPY 500" {
+		t.Errorf("Sscanf: wanted JPY 500, got %v (%v)", m, err)
+	}
+	for _, s := range []string{"", "GBP", "GBP 12.3", "FOO 1.00"} {
+		if _, err := fmt.Sscan(s, &m); err == nil {
+			t.Errorf("error expected from Sscan(%q), none received, got %v", s, m)
+		}
+	}
+	if _, err := fmt.Sscanf("GBP 1.00", "%d", &m); err == nil {
+		t.Errorf("error expected from Sscanf with %%d, none received")
+	}
+}
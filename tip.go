@@ -0,0 +1,53 @@
+package dough
+
+import "fmt"
+
+// Tip is a tip calculated by CalculateTip.
+type Tip struct {
+	// Tip is the amount of the tip.
+	Tip Money
+	// Total is the bill plus the tip.
+	Total Money
+	// Percent is the effective tip as a percentage of the bill, after any rounding of the total.
+	Percent float64
+}
+
+// CalculateTip calculates a tip of percent% of bill, rounded half up.
+// If roundTo is non-zero, the total is then rounded up to the next multiple of roundTo,
+// e.g. 0.50 or 1.00, and the tip increased to match.
+// It returns an error if bill is negative, percent is negative or not finite,
+// or roundTo is negative or in a different currency, or one wrapping ErrOutOfRange if the total would overflow.
+func CalculateTip(bill Money, percent float64, roundTo Money) (Tip, error) {
+	if bill.a < 0 {
+		return Tip{}, fmt.Errorf("can't calculate tip on negative bill %v", bill)
+	}
+	tip, err := percentOf(bill, percent, HalfUp)
+	if err != nil {
+		return Tip{}, err
+	}
+	total, ok := addAtoms(bill.a, tip.a)
+	if !ok {
+		return Tip{}, fmt.Errorf("%w: %v + %v tip", ErrOutOfRange, bill, tip)
+	}
+	if roundTo.a != 0 {
+		if roundTo.Currency() != bill.Currency() {
+			return Tip{}, fmt.Errorf("Can't round %s total to %s", bill.Currency(), roundTo.Currency())
+		}
+		if roundTo.a < 0 {
+			return Tip{}, fmt.Errorf("can't round total to negative amount %v", roundTo)
+		}
+		if r := total % roundTo.a; r != 0 {
+			if total, ok = addAtoms(total, roundTo.a-r); !ok {
+				return Tip{}, fmt.Errorf("%w: total of %v rounded up to a multiple of %v", ErrOutOfRange, bill, roundTo)
+			}
+		}
+	}
+	t := Tip{
+		Tip:   Money{bill.c, total - bill.a},
+		Total: Money{bill.c, total},
+	}
+	if bill.a != 0 {
+		t.Percent = percentage(t.Tip.a, bill.a)
+	}
+	return t, nil
+}
@@ -0,0 +1,70 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCanCalculateTip(t *testing.T) {
+	var cases = []struct {
+		bill    string
+		percent float64
+		roundTo string
+		tip     string
+		total   string
+		eff     float64
+	}{
+		{"40.00", 12.5, "0.00", "5.00", "45.00", 12.5},
+		{"43.27", 15, "0.00", "6.49", "49.76", 14.998844},
+		{"43.27", 15, "0.50", "6.73", "50.00", 15.553501},
+		{"43.27", 15, "1.00", "6.73", "50.00", 15.553501},
+		{"42.00", 0, "1.00", "0.00", "42.00", 0},
+		{"42.10", 0, "1.00", "0.90", "43.00", 2.137767},
+		{"0.00", 20, "1.00", "0.00", "0.00", 0},
+	}
+	for _, c := range cases {
+		bill, _ := New("GBP", c.bill)
+		roundTo, _ := New("GBP", c.roundTo)
+		got, err := CalculateTip(bill, c.percent, roundTo)
+		if err != nil {
+			t.Errorf("error received from CalculateTip(%s, %v, %s), none expected %v", c.bill, c.percent, c.roundTo, err)
+			continue
+		}
+		if got.Tip.Amount() != c.tip || got.Total.Amount() != c.total || math.Abs(got.Percent-c.eff) > 1e-6 {
+			t.Errorf("CalculateTip(%s, %v, %s): wanted %s, %s, %v%%, got %s, %s, %v%%", c.bill, c.percent, c.roundTo, c.tip, c.total, c.eff, got.Tip.Amount(), got.Total.Amount(), got.Percent)
+		}
+	}
+}
+
+func TestCanRejectBadTip(t *testing.T) {
+	bill, _ := New("GBP", "10.00")
+	neg, _ := New("GBP", "-0.50")
+	eur, _ := New("EUR", "0.50")
+	if _, err := CalculateTip(neg, 10, Money{}); err == nil {
+		t.Errorf("error expected with negative bill, none received")
+	}
+	if _, err := CalculateTip(bill, -10, Money{}); err == nil {
+		t.Errorf("error expected with negative percent, none received")
+	}
+	if _, err := CalculateTip(bill, 10, neg); err == nil {
+		t.Errorf("error expected rounding to negative amount, none received")
+	}
+	if _, err := CalculateTip(bill, 10, eur); err == nil {
+		t.Errorf("error expected rounding to different currency, none received")
+	}
+}
+
+func TestCanRejectTipOverflow(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	if _, err := CalculateTip(hi, 10, Money{}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("CalculateTip(%v, 10): wanted ErrOutOfRange, got %v", hi, err)
+	}
+	if _, err := CalculateTip(hi, 0, gbp("1.00")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("CalculateTip(%v, 0) rounded to 1.00: wanted ErrOutOfRange, got %v", hi, err)
+	}
+	// The effective percentage is calculated exactly, however large the tip.
+	if got, err := CalculateTip(gbp("0.01"), 0, MustNew("GBP", "90000000000000000.00")); err != nil || got.Percent != 9e20 {
+		t.Errorf("wanted a tip of 9e20%%, got %v (%v)", got.Percent, err)
+	}
+}
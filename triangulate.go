@@ -0,0 +1,94 @@
+package dough
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrNoRatePath is returned, wrapped, by a Triangulator when there is no way to convert between
+// two currencies within its policy.
+var ErrNoRatePath = errors.New("no rate path")
+
+// Triangulator resolves rates between currencies that have no direct rate, by chaining rates
+// through bridge currencies, within an explicit policy, so that a conversion never silently goes
+// through an unintended, illiquid pair.
+// A Triangulator is a RateProvider, so it can be used with Convert.
+type Triangulator struct {
+	Rates RateProvider
+	// Bridges are the currencies a conversion may go through, in order of preference.
+	Bridges []string
+	// MaxHops is the most rates a conversion may chain, e.g. 2 for GBP → USD → JPY.
+	// Values less than 1 mean 1, i.e. only direct rates.
+	MaxHops int
+}
+
+// Path returns the shortest chain of rates from one currency to another, going only through
+// Bridges. Among chains of the same length, the one through the most preferred bridges is chosen.
+// A rate for which Rates returns an error is treated as unavailable.
+// It returns an error if either currency is not well formed or not recognised, or one wrapping
+// ErrNoRatePath if there is no such chain within MaxHops.
+func (t Triangulator) Path(from, to string) ([]Rate, error) {
+	if _, err := NewRate(from, to, big.NewRat(1, 1)); err != nil {
+		return nil, err
+	}
+	limit := t.MaxHops
+	if limit < 1 {
+		limit = 1
+	}
+	for hops := 1; hops <= limit; hops++ {
+		if path := t.search(from, to, hops, map[string]bool{from: true, to: true}); path != nil {
+			return path, nil
+		}
+	}
+	return nil, fmt.Errorf("%w from %s to %s within %d hops via %v", ErrNoRatePath, from, to, limit, t.Bridges)
+}
+
+// search returns a chain of exactly hops rates from one currency to another, or nil if there is none.
+func (t Triangulator) search(from, to string, hops int, used map[string]bool) []Rate {
+	if hops == 1 {
+		if r, ok := t.rate(from, to); ok {
+			return []Rate{r}
+		}
+		return nil
+	}
+	for _, b := range t.Bridges {
+		if used[b] {
+			continue
+		}
+		r, ok := t.rate(from, b)
+		if !ok {
+			continue
+		}
+		used[b] = true
+		rest := t.search(b, to, hops-1, used)
+		used[b] = false
+		if rest != nil {
+			return append([]Rate{r}, rest...)
+		}
+	}
+	return nil
+}
+
+// rate returns the Rate from Rates, with ok false if it is unavailable or invalid.
+func (t Triangulator) rate(from, to string) (Rate, bool) {
+	r, err := t.Rates.Rate(from, to)
+	if err != nil {
+		return Rate{}, false
+	}
+	rate, err := NewRate(from, to, r)
+	return rate, err == nil
+}
+
+// Rate implements RateProvider, returning the product of the rates on the Path.
+func (t Triangulator) Rate(from, to string) (*big.Rat, error) {
+	path, err := t.Path(from, to)
+	if err != nil {
+		return nil, err
+	}
+	r := path[0]
+	for _, s := range path[1:] {
+		r, _ = r.Compose(s)
+	}
+	return r.Rat(), nil
+}
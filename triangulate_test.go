@@ -0,0 +1,71 @@
+package dough
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanTriangulate(t *testing.T) {
+	var cases = []struct {
+		tri      Triangulator
+		from, to string
+		want     []string
+	}{
+		{Triangulator{Rates: testRates}, "GBP", "USD", []string{"GBP/USD 1.25"}},
+		{Triangulator{Rates: testRates, Bridges: []string{"USD"}, MaxHops: 2}, "GBP", "USD", []string{"GBP/USD 1.25"}},
+		{Triangulator{Rates: testRates, Bridges: []string{"USD"}, MaxHops: 2}, "GBP", "JPY", []string{"GBP/USD 1.25", "USD/JPY 150"}},
+		{Triangulator{Rates: testRates, Bridges: []string{"EUR", "USD"}, MaxHops: 3}, "GBP", "EUR", []string{"GBP/USD 1.25", "USD/EUR 0.90909090909090909091"}},
+		{Triangulator{Rates: testRates, Bridges: []string{"JPY", "USD"}, MaxHops: 3}, "GBP", "EUR", []string{"GBP/USD 1.25", "USD/EUR 0.90909090909090909091"}},
+		{Triangulator{Rates: testRates, Bridges: []string{"JPY", "USD"}, MaxHops: 3}, "EUR", "JPY", []string{"EUR/USD 1.1", "USD/JPY 150"}},
+	}
+	for i, c := range cases {
+		path, err := c.tri.Path(c.from, c.to)
+		if err != nil {
+			t.Errorf("%d: error received from Path(%s, %s), none expected %v", i, c.from, c.to, err)
+			continue
+		}
+		got := make([]string, len(path))
+		for j, r := range path {
+			got[j] = r.String()
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%d: Path(%s, %s): wanted %v, got %v", i, c.from, c.to, c.want, got)
+			continue
+		}
+		for j := range got {
+			if got[j] != c.want[j] {
+				t.Errorf("%d: Path(%s, %s): wanted %v, got %v", i, c.from, c.to, c.want, got)
+				break
+			}
+		}
+	}
+	tri := Triangulator{Rates: testRates, Bridges: []string{"USD"}, MaxHops: 2}
+	if got, err := Convert(MustNew("GBP", "1.00"), "JPY", tri, HalfUp); err != nil || got.String() != "JPY 188" {
+		t.Errorf("Convert via Triangulator: wanted JPY 188, got %v (%v)", got, err)
+	}
+}
+
+func TestCanRejectUnmetRatePolicy(t *testing.T) {
+	var cases = []struct {
+		tri      Triangulator
+		from, to string
+	}{
+		{Triangulator{Rates: testRates}, "GBP", "JPY"},
+		{Triangulator{Rates: testRates, Bridges: []string{"USD"}, MaxHops: 1}, "GBP", "JPY"},
+		{Triangulator{Rates: testRates, Bridges: []string{"EUR"}, MaxHops: 3}, "GBP", "JPY"},
+		{Triangulator{Rates: testRates, Bridges: []string{"USD"}, MaxHops: 2}, "GBP", "CHF"},
+	}
+	for i, c := range cases {
+		path, err := c.tri.Path(c.from, c.to)
+		if !errors.Is(err, ErrNoRatePath) {
+			t.Errorf("%d: Path(%s, %s): wanted ErrNoRatePath, got %v (%v)", i, c.from, c.to, path, err)
+		}
+		if _, err := c.tri.Rate(c.from, c.to); !errors.Is(err, ErrNoRatePath) {
+			t.Errorf("%d: Rate(%s, %s): wanted ErrNoRatePath, got %v", i, c.from, c.to, err)
+		}
+	}
+	tri := Triangulator{Rates: testRates}
+	if _, err := tri.Path("FOO", "USD"); err == nil || errors.Is(err, ErrNoRatePath) {
+		t.Errorf("Path(FOO, USD): wanted currency error, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// NPV returns the net present value of a series of cash flows, discounted at rate per period.
+// flows[0] occurs now and is not discounted; flows[i] is discounted by (1+rate)^i.
+// The flows are accumulated exactly and rounded once, using mode.
+// It returns an error if there are no flows, if the flows are in different currencies,
+// or if rate is nil or not greater than -1.
+func NPV(rate *big.Rat, flows []Money, mode RoundingMode) (Money, error) {
+	if len(flows) == 0 {
+		return Money{}, fmt.Errorf("can't calculate NPV of no cash flows")
+	}
+	f, err := discountFactor(rate)
+	if err != nil {
+		return Money{}, err
+	}
+	sum := new(big.Rat)
+	d := big.NewRat(1, 1)
+	for i, y := range flows {
+		if y.Currency() != flows[0].Currency() {
+			return Money{}, fmt.Errorf("Can't calculate NPV of different currencies. Flow %d is %s, expected %s", i, y.Currency(), flows[0].Currency())
+		}
+		t := new(big.Rat).SetInt64(int64(y.a))
+		sum.Add(sum, t.Mul(t, d))
+		d.Mul(d, f)
+	}
+	a, err := roundAtoms(sum, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{
+		flows[0].c,
+		a,
+	}, nil
+}
+
+// FV returns the future value of x after compounding at rate for n periods, rounded using mode.
+// It returns an error if n is negative, or if rate is nil or not greater than -1.
+func FV(x Money, rate *big.Rat, n int, mode RoundingMode) (Money, error) {
+	if n < 0 {
+		return Money{}, fmt.Errorf("invalid number of periods: %d", n)
+	}
+	if _, err := discountFactor(rate); err != nil {
+		return Money{}, err
+	}
+	g := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	return compound(x, g, n, mode)
+}
+
+// PV returns the present value of x, discounted at rate for n periods, rounded using mode.
+// It returns an error if n is negative, or if rate is nil or not greater than -1.
+func PV(x Money, rate *big.Rat, n int, mode RoundingMode) (Money, error) {
+	if n < 0 {
+		return Money{}, fmt.Errorf("invalid number of periods: %d", n)
+	}
+	f, err := discountFactor(rate)
+	if err != nil {
+		return Money{}, err
+	}
+	return compound(x, f, n, mode)
+}
+
+// discountFactor returns 1/(1+rate).
+// It returns an error if rate is nil or not greater than -1.
+func discountFactor(rate *big.Rat) (*big.Rat, error) {
+	if rate == nil {
+		return nil, fmt.Errorf("invalid rate: nil")
+	}
+	g := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	if g.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid rate: %s", rate.RatString())
+	}
+	return g.Inv(g), nil
+}
+
+// compound returns x multiplied by f^n, rounded using mode.
+// f^n is computed by squaring, as n may be large, e.g. for daily compounding over decades.
+func compound(x Money, f *big.Rat, n int, mode RoundingMode) (Money, error) {
+	e := big.NewInt(int64(n))
+	num := new(big.Int).Exp(f.Num(), e, nil)
+	den := new(big.Int).Exp(f.Denom(), e, nil)
+	r := new(big.Rat).SetFrac(num.Mul(num, big.NewInt(int64(x.a))), den)
+	a, err := roundAtoms(r, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{
+		x.c,
+		a,
+	}, nil
+}
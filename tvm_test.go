@@ -0,0 +1,94 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanCalculateNPV(t *testing.T) {
+	var cases = []struct {
+		rate  string
+		flows []string
+		mode  RoundingMode
+		want  string
+	}{
+		{"0", []string{"-100.00", "50.00", "60.00"}, HalfUp, "10.00"},
+		{"1/10", []string{"-100.00", "110.00"}, HalfUp, "0.00"},
+		{"1/10", []string{"-100.00", "60.00", "60.00"}, HalfUp, "4.13"},
+		{"1/10", []string{"0.00", "100.00"}, HalfUp, "90.91"},
+		{"1/10", []string{"0.00", "100.00"}, Down, "90.90"},
+		{"1/20", []string{"1000.00"}, HalfUp, "1000.00"},
+	}
+	for _, c := range cases {
+		rate, _ := new(big.Rat).SetString(c.rate)
+		flows := make([]Money, len(c.flows))
+		for i := range c.flows {
+			flows[i], _ = New("GBP", c.flows[i])
+		}
+		got, err := NPV(rate, flows, c.mode)
+		if err != nil {
+			t.Errorf("error received from NPV(%s, %v), none expected %v", c.rate, c.flows, err)
+		}
+		if got.Amount() != c.want {
+			t.Errorf("NPV(%s, %v): wanted %s, got %s", c.rate, c.flows, c.want, got.Amount())
+		}
+	}
+}
+
+func TestCanRejectBadNPV(t *testing.T) {
+	a, _ := New("GBP", "1.00")
+	b, _ := New("EUR", "1.00")
+	if _, err := NPV(big.NewRat(1, 10), nil, HalfUp); err == nil {
+		t.Errorf("error expected from NPV with no flows, none received")
+	}
+	if _, err := NPV(big.NewRat(1, 10), []Money{a, b}, HalfUp); err == nil {
+		t.Errorf("error expected from NPV with mixed currencies, none received")
+	}
+	if _, err := NPV(big.NewRat(-1, 1), []Money{a}, HalfUp); err == nil {
+		t.Errorf("error expected from NPV with rate of -1, none received")
+	}
+}
+
+func TestCanCalculateFutureAndPresentValue(t *testing.T) {
+	var cases = []struct {
+		amt  string
+		rate string
+		n    int
+		fv   string
+		pv   string
+	}{
+		{"100.00", "1/10", 0, "100.00", "100.00"},
+		{"100.00", "1/10", 1, "110.00", "90.91"},
+		{"100.00", "1/10", 2, "121.00", "82.64"},
+		{"1000.00", "1/20", 10, "1628.89", "613.91"},
+		{"-100.00", "1/10", 1, "-110.00", "-90.91"},
+		// 5% a year compounded daily for 30 years.
+		{"1000.00", "1/7300", 10950, "4481.23", "223.15"},
+	}
+	for _, c := range cases {
+		x, _ := New("GBP", c.amt)
+		rate, _ := new(big.Rat).SetString(c.rate)
+		if got, _ := FV(x, rate, c.n, HalfUp); got.Amount() != c.fv {
+			t.Errorf("FV(%s, %s, %d): wanted %s, got %s", c.amt, c.rate, c.n, c.fv, got.Amount())
+		}
+		if got, _ := PV(x, rate, c.n, HalfUp); got.Amount() != c.pv {
+			t.Errorf("PV(%s, %s, %d): wanted %s, got %s", c.amt, c.rate, c.n, c.pv, got.Amount())
+		}
+	}
+	x, _ := New("GBP", "1.00")
+	if _, err := FV(x, big.NewRat(1, 10), -1, HalfUp); err == nil {
+		t.Errorf("error expected from FV with negative periods, none received")
+	}
+	if _, err := PV(x, big.NewRat(-2, 1), 1, HalfUp); err == nil {
+		t.Errorf("error expected from PV with rate below -1, none received")
+	}
+	if _, err := FV(x, nil, 1, HalfUp); err == nil {
+		t.Errorf("error expected from FV with nil rate, none received")
+	}
+	if _, err := PV(x, nil, 1, HalfUp); err == nil {
+		t.Errorf("error expected from PV with nil rate, none received")
+	}
+	if _, err := NPV(nil, []Money{x}, HalfUp); err == nil {
+		t.Errorf("error expected from NPV with nil rate, none received")
+	}
+}
@@ -0,0 +1,104 @@
+package dough
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// UnitPrice is a price per unit of some quantity, e.g. £3.99 per kg.
+// Unlike Money, a UnitPrice may be more precise than its currency's minor unit, e.g. £0.0125 each.
+// The zero value is not a valid UnitPrice.
+type UnitPrice struct {
+	c currency.Unit
+	r *big.Rat
+}
+
+// NewUnitPrice returns a new UnitPrice in the given currency, e.g. NewUnitPrice("GBP", "3.99").
+// The amount may have any number of decimal places.
+// It returns an error if cur is not well formed or not recognised, or if amt is not a decimal number.
+func NewUnitPrice(cur, amt string) (UnitPrice, error) {
+	c, err := currency.ParseISO(cur)
+	if err != nil {
+		return UnitPrice{}, fmt.Errorf("coudn't parse currency: %v", err)
+	}
+	r, err := parseDecimal(amt)
+	if err != nil {
+		return UnitPrice{}, err
+	}
+	return UnitPrice{c, r}, nil
+}
+
+// UnitPriceOf returns x as a UnitPrice.
+func UnitPriceOf(x Money) UnitPrice {
+	return UnitPrice{x.c, x.Rat()}
+}
+
+// parseDecimal parses a decimal number with an optional sign and any number of decimal places, e.g. "-3.990".
+func parseDecimal(s string) (*big.Rat, error) {
+	d := strings.TrimPrefix(s, "-")
+	whole, frac, _ := strings.Cut(d, ".")
+	ok := len(whole)+len(frac) > 0
+	for i := 0; ok && i < len(whole); i++ {
+		ok = isDigit(whole[i])
+	}
+	for i := 0; ok && i < len(frac); i++ {
+		ok = isDigit(frac[i])
+	}
+	if !ok {
+		return nil, fmt.Errorf("couldn't parse decimal %q", s)
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return r, nil
+}
+
+// Currency gets the currency of the UnitPrice.
+func (p UnitPrice) Currency() string {
+	return p.c.String()
+}
+
+// Rat returns the exact price per unit in major units.
+func (p UnitPrice) Rat() *big.Rat {
+	return new(big.Rat).Set(p.r)
+}
+
+// Amount returns the price per unit as a decimal string, with at least as many decimal places
+// as the currency's minor unit, e.g. "3.99" or "0.0125". Recurring decimals are rounded to 20 places.
+func (p UnitPrice) Amount() string {
+	places := exponent(p.c)
+	t := new(big.Rat).Mul(p.r, new(big.Rat).SetInt(new(big.Int).SetUint64(pow10[places])))
+	for ; !t.IsInt() && places < maxUnitPricePlaces; places++ {
+		t.Mul(t, big.NewRat(10, 1))
+	}
+	return p.r.FloatString(places)
+}
+
+// maxUnitPricePlaces limits the decimal places Amount shows for a price that isn't a terminating decimal.
+const maxUnitPricePlaces = 20
+
+// String returns the currency code and price per unit, e.g. "GBP 3.99".
+func (p UnitPrice) String() string {
+	return p.Currency() + " " + p.Amount()
+}
+
+// Extend returns the total price of qty units, e.g. 2.350 kg at £3.99/kg.
+// The total is calculated exactly and only then rounded to the currency's minor unit, using mode.
+// It returns an error if the total can't be represented.
+func (p UnitPrice) Extend(qty *big.Rat, mode RoundingMode) (Money, error) {
+	return fromRat(p.c, new(big.Rat).Mul(p.r, qty), mode)
+}
+
+// NormalizePrice returns the price of per units of measure, given the price of a pack containing
+// packSize units, e.g. the price per 100g of a 750g pack. packSize and per must be in the same unit
+// of measure, e.g. both in grams; use a per of 1 for a price each. The result is rounded to the
+// currency's minor unit using mode.
+// It returns an error if packSize or per isn't positive, or if the result can't be represented.
+func NormalizePrice(price Money, packSize, per *big.Rat, mode RoundingMode) (Money, error) {
+	if packSize.Sign() <= 0 || per.Sign() <= 0 {
+		return Money{}, fmt.Errorf("pack size and measure must be positive, got %s and %s", packSize.RatString(), per.RatString())
+	}
+	r := new(big.Rat).Quo(price.Rat(), packSize)
+	return fromRat(price.c, r.Mul(r, per), mode)
+}
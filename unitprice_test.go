@@ -0,0 +1,121 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCanCreateUnitPrice(t *testing.T) {
+	var cases = []struct {
+		cur  string
+		amt  string
+		want string
+	}{
+		{"GBP", "3.99", "GBP 3.99"},
+		{"GBP", "3.9", "GBP 3.90"},
+		{"GBP", "0.0125", "GBP 0.0125"},
+		{"GBP", "-1", "GBP -1.00"},
+		{"GBP", ".5", "GBP 0.50"},
+		{"JPY", "12.5", "JPY 12.5"},
+		{"JPY", "12", "JPY 12"},
+	}
+	for _, c := range cases {
+		got, err := NewUnitPrice(c.cur, c.amt)
+		if err != nil {
+			t.Errorf("error received from NewUnitPrice(%s, %s), none expected %v", c.cur, c.amt, err)
+			continue
+		}
+		if got.String() != c.want {
+			t.Errorf("NewUnitPrice(%s, %s): wanted %s, got %s", c.cur, c.amt, c.want, got)
+		}
+	}
+	if got := UnitPriceOf(gbp("3.99")).String(); got != "GBP 3.99" {
+		t.Errorf("UnitPriceOf(GBP 3.99): wanted GBP 3.99, got %s", got)
+	}
+}
+
+func TestCanRejectBadUnitPrice(t *testing.T) {
+	var cases = []struct {
+		cur string
+		amt string
+	}{
+		{"FOO", "1.00"},
+		{"GBP", ""},
+		{"GBP", "."},
+		{"GBP", "1/3"},
+		{"GBP", "1e3"},
+		{"GBP", "1.2.3"},
+		{"GBP", "+1"},
+		{"GBP", "--1"},
+	}
+	for _, c := range cases {
+		if got, err := NewUnitPrice(c.cur, c.amt); err == nil {
+			t.Errorf("error expected from NewUnitPrice(%s, %s), none received, got %v", c.cur, c.amt, got)
+		}
+	}
+}
+
+func TestCanExtendUnitPrice(t *testing.T) {
+	var cases = []struct {
+		price string
+		qty   string
+		mode  RoundingMode
+		want  string
+	}{
+		{"3.99", "2.350", HalfUp, "9.38"},
+		{"3.99", "2.350", Down, "9.37"},
+		{"3.99", "3", HalfUp, "11.97"},
+		{"0.0125", "7", HalfEven, "0.09"},
+		{"0.0125", "1000", HalfEven, "12.50"},
+		{"10.00", "1/3", HalfUp, "3.33"},
+		{"3.99", "-0.5", HalfUp, "-2.00"},
+		{"3.99", "0", HalfUp, "0.00"},
+	}
+	for _, c := range cases {
+		p, _ := NewUnitPrice("GBP", c.price)
+		qty, _ := new(big.Rat).SetString(c.qty)
+		got, err := p.Extend(qty, c.mode)
+		if err != nil {
+			t.Errorf("error received from %s.Extend(%s), none expected %v", c.price, c.qty, err)
+			continue
+		}
+		if got.Amount() != c.want {
+			t.Errorf("%s.Extend(%s, %v): wanted %s, got %s", c.price, c.qty, c.mode, c.want, got.Amount())
+		}
+	}
+}
+
+func TestCanNormalizePrice(t *testing.T) {
+	var cases = []struct {
+		price string
+		pack  string
+		per   string
+		mode  RoundingMode
+		want  string
+	}{
+		{"2.50", "750", "100", HalfUp, "0.33"},
+		{"2.50", "750", "100", Up, "0.34"},
+		{"2.50", "750", "1000", HalfUp, "3.33"},
+		{"1.99", "330", "1000", HalfEven, "6.03"},
+		{"4.00", "6", "1", HalfUp, "0.67"},
+		{"4.00", "0.5", "1", HalfUp, "8.00"},
+		{"0.00", "100", "100", HalfUp, "0.00"},
+	}
+	for _, c := range cases {
+		pack, _ := new(big.Rat).SetString(c.pack)
+		per, _ := new(big.Rat).SetString(c.per)
+		got, err := NormalizePrice(gbp(c.price), pack, per, c.mode)
+		if err != nil {
+			t.Errorf("error received from NormalizePrice(%s, %s, %s), none expected %v", c.price, c.pack, c.per, err)
+			continue
+		}
+		if got.Amount() != c.want {
+			t.Errorf("NormalizePrice(%s, %s, %s, %v): wanted %s, got %s", c.price, c.pack, c.per, c.mode, c.want, got.Amount())
+		}
+	}
+	for _, c := range [][2]int64{{0, 100}, {-1, 100}, {100, 0}} {
+		if got, err := NormalizePrice(gbp("1.00"), big.NewRat(c[0], 1), big.NewRat(c[1], 1), HalfUp); err == nil {
+			t.Errorf("error expected from NormalizePrice(1.00, %d, %d), none received, got %v", c[0], c[1], got)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package dough
+
+import "fmt"
+
+// Adjustment is a step in a price Waterfall, such as a discount, surcharge or tax.
+type Adjustment interface {
+	// Adjust returns the change the adjustment makes to price, e.g. GBP -5.00 for a £5 discount.
+	Adjust(price Money) (Money, error)
+}
+
+// AdjustmentFunc is an adapter to allow the use of ordinary functions as Adjustments.
+type AdjustmentFunc func(price Money) (Money, error)
+
+// Adjust calls f(price).
+func (f AdjustmentFunc) Adjust(price Money) (Money, error) {
+	return f(price)
+}
+
+// Percentage returns an Adjustment of p of the price, rounded using mode.
+// Use a negative Proportion for a discount, e.g. NewPercent(-10).
+func Percentage(p Proportion, mode RoundingMode) Adjustment {
+	return AdjustmentFunc(func(price Money) (Money, error) {
+		return p.Of(price, mode)
+	})
+}
+
+// Fixed returns an Adjustment of a fixed amount, e.g. GBP -5.00 for £5 off.
+func Fixed(amount Money) Adjustment {
+	return AdjustmentFunc(func(price Money) (Money, error) {
+		if amount.c != price.c {
+			return Money{}, fmt.Errorf("Can't adjust %s price by %s", price.Currency(), amount.Currency())
+		}
+		return amount, nil
+	})
+}
+
+// SurchargeAdjustment returns an Adjustment of the surcharge s on the price, rounded using mode.
+func SurchargeAdjustment(s Surcharge, mode RoundingMode) Adjustment {
+	return AdjustmentFunc(func(price Money) (Money, error) {
+		surcharge, _, err := s.Apply(price, mode)
+		return surcharge, err
+	})
+}
+
+// WaterfallStep is a named Adjustment in a Waterfall.
+type WaterfallStep struct {
+	Name       string
+	Adjustment Adjustment
+}
+
+// Waterfall applies adjustments to a price in a declared order, e.g.
+// list price → contract discount → promotion → surcharge → tax,
+// each adjustment seeing the price as adjusted by the steps before it.
+// The zero value has no steps. A Waterfall is immutable, so it is safe to share and extend.
+type Waterfall struct {
+	steps []WaterfallStep
+}
+
+// Then returns a new Waterfall with the named adjustment added after w's steps.
+func (w Waterfall) Then(name string, a Adjustment) Waterfall {
+	steps := make([]WaterfallStep, len(w.steps), len(w.steps)+1)
+	copy(steps, w.steps)
+	return Waterfall{append(steps, WaterfallStep{name, a})}
+}
+
+// Steps returns the steps of w, in order.
+func (w Waterfall) Steps() []WaterfallStep {
+	return append([]WaterfallStep(nil), w.steps...)
+}
+
+// AppliedStep is a step of a Waterfall as applied to a price.
+type AppliedStep struct {
+	Name   string
+	Before Money
+	Change Money
+	After  Money
+}
+
+// PriceBreakdown is an auditable record of a Waterfall applied to a price.
+// Each step's After is the next step's Before, and Final is the last step's After.
+type PriceBreakdown struct {
+	Start Money
+	Steps []AppliedStep
+	Final Money
+}
+
+// Apply applies the steps of w to price in order.
+// It returns an error, naming the step, if an adjustment fails, returns a change in a different
+// currency, or the price can't be represented.
+func (w Waterfall) Apply(price Money) (PriceBreakdown, error) {
+	b := PriceBreakdown{Start: price, Steps: make([]AppliedStep, len(w.steps))}
+	for i, s := range w.steps {
+		d, err := s.Adjustment.Adjust(price)
+		if err != nil {
+			return PriceBreakdown{}, fmt.Errorf("step %q: %w", s.Name, err)
+		}
+		after, err := price.Add(d)
+		if err != nil {
+			return PriceBreakdown{}, fmt.Errorf("step %q: %w", s.Name, err)
+		}
+		b.Steps[i] = AppliedStep{s.Name, price, d, after}
+		price = after
+	}
+	b.Final = price
+	return b, nil
+}
@@ -0,0 +1,73 @@
+package dough
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCanApplyWaterfall(t *testing.T) {
+	pct := func(p float64) Proportion {
+		r, _ := NewPercent(p)
+		return r
+	}
+	w := Waterfall{}.
+		Then("contract", Percentage(pct(-10), HalfUp)).
+		Then("promo", Fixed(gbp("-5.00"))).
+		Then("card surcharge", SurchargeAdjustment(Surcharge{Rate: pct(1.5)}, HalfUp)).
+		Then("VAT", Percentage(pct(20), HalfUp))
+	got, err := w.Apply(gbp("99.99"))
+	if err != nil {
+		t.Fatalf("error received from Apply, none expected %v", err)
+	}
+	want := PriceBreakdown{
+		Start: gbp("99.99"),
+		Steps: []AppliedStep{
+			{"contract", gbp("99.99"), gbp("-10.00"), gbp("89.99")},
+			{"promo", gbp("89.99"), gbp("-5.00"), gbp("84.99")},
+			{"card surcharge", gbp("84.99"), gbp("1.27"), gbp("86.26")},
+			{"VAT", gbp("86.26"), gbp("17.25"), gbp("103.51")},
+		},
+		Final: gbp("103.51"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %+v, got %+v", want, got)
+	}
+	if n := len(w.Steps()); n != 4 {
+		t.Errorf("wanted 4 steps, got %d", n)
+	}
+}
+
+func TestCanComposeWaterfalls(t *testing.T) {
+	base := Waterfall{}.Then("promo", Fixed(gbp("-1.00")))
+	a := base.Then("a", Fixed(gbp("0.10")))
+	b := base.Then("b", Fixed(gbp("0.20")))
+	if got, _ := a.Apply(gbp("10.00")); got.Final != gbp("9.10") {
+		t.Errorf("wanted 9.10, got %v", got.Final)
+	}
+	if got, _ := b.Apply(gbp("10.00")); got.Final != gbp("9.20") {
+		t.Errorf("wanted 9.20, got %v", got.Final)
+	}
+	if got, err := (Waterfall{}).Apply(gbp("10.00")); err != nil || got.Final != gbp("10.00") || len(got.Steps) != 0 {
+		t.Errorf("empty waterfall: wanted 10.00 with no steps, got %+v (%v)", got, err)
+	}
+}
+
+func TestCanRejectBadWaterfall(t *testing.T) {
+	hi, _ := MaxValue("GBP")
+	fail := AdjustmentFunc(func(Money) (Money, error) { return Money{}, fmt.Errorf("no price") })
+	var cases = []struct {
+		w     Waterfall
+		price Money
+	}{
+		{Waterfall{}.Then("fx", Fixed(MustNew("EUR", "1.00"))), gbp("1.00")},
+		{Waterfall{}.Then("fail", fail), gbp("1.00")},
+		{Waterfall{}.Then("overflow", Fixed(gbp("0.01"))), hi},
+		{Waterfall{}.Then("surcharge", SurchargeAdjustment(Surcharge{}, HalfUp)), gbp("-1.00")},
+	}
+	for _, c := range cases {
+		if got, err := c.w.Apply(c.price); err == nil {
+			t.Errorf("error expected from Apply, none received, got %+v", got)
+		}
+	}
+}